@@ -0,0 +1,158 @@
+package datadog
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dataSourceDatadogGraphSnapshot is implemented with the SDK rather than the framework so that its `request`
+// block shares the timeseries request schema and builders of the `datadog_dashboard` resource.
+func dataSourceDatadogGraphSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to take a snapshot of a graph, for example to embed it in generated documentation. A new snapshot is taken every time the data source is read.",
+		ReadContext: dataSourceDatadogGraphSnapshotRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"metric_query": {
+					Description:  "The metric query to graph. Exactly one of `metric_query` or `request` is required.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					ExactlyOneOf: []string{"metric_query", "request"},
+				},
+				"request": {
+					Description:  "A nested block describing the request to graph, using the same structure as the `request` block of a `timeseries_definition` widget of the `datadog_dashboard` resource. Multiple `request` blocks are allowed. Exactly one of `metric_query` or `request` is required.",
+					Type:         schema.TypeList,
+					Optional:     true,
+					ExactlyOneOf: []string{"metric_query", "request"},
+					Elem: &schema.Resource{
+						Schema: getTimeseriesRequestSchema(),
+					},
+				},
+				"event_query": {
+					Description: "A query that adds event bands to the graph.",
+					Type:        schema.TypeString,
+					Optional:    true,
+				},
+				"title": {
+					Description: "The title of the graph.",
+					Type:        schema.TypeString,
+					Optional:    true,
+				},
+				"height": {
+					Description:  "The height of the graph, in pixels.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"width": {
+					Description:  "The width of the graph, in pixels.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"timeframe": {
+					Description:  "The duration of the graphed time window, ending at `end`, as a Go duration string such as `1h` or `30m`.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "1h",
+					ValidateFunc: validators.ValidatePositiveDuration,
+				},
+				"end": {
+					Description:  "The POSIX timestamp of the end of the graphed time window. Defaults to the time the data source is read.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				// Computed values
+				"start": {
+					Description: "The POSIX timestamp of the start of the graphed time window.",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+				"graph_def": {
+					Description: "The JSON graph definition sent to Datadog when `request` is used.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"snapshot_url": {
+					Description: "The URL of the graph snapshot.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+			}
+		},
+	}
+}
+
+func dataSourceDatadogGraphSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.Auth
+
+	timeframe, _ := time.ParseDuration(d.Get("timeframe").(string))
+	end := providerConf.Now().Unix()
+	if v, ok := d.GetOk("end"); ok {
+		end = int64(v.(int))
+	}
+	start := end - int64(timeframe.Seconds())
+
+	optionalParams := datadogV1.NewGetGraphSnapshotOptionalParameters()
+	if v, ok := d.GetOk("metric_query"); ok {
+		optionalParams.WithMetricQuery(v.(string))
+	}
+	graphDef := ""
+	if v, ok := d.GetOk("request"); ok {
+		terraformRequests := v.([]interface{})
+		definition := datadogV1.NewTimeseriesWidgetDefinitionWithDefaults()
+		definition.SetRequests(*buildDatadogTimeseriesRequests(&terraformRequests))
+		if title, ok := d.GetOk("title"); ok {
+			definition.SetTitle(title.(string))
+		}
+		b, err := json.Marshal(definition)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		graphDef = string(b)
+		optionalParams.WithGraphDef(graphDef)
+	}
+	if v, ok := d.GetOk("event_query"); ok {
+		optionalParams.WithEventQuery(v.(string))
+	}
+	if v, ok := d.GetOk("title"); ok {
+		optionalParams.WithTitle(v.(string))
+	}
+	if v, ok := d.GetOk("height"); ok {
+		optionalParams.WithHeight(int64(v.(int)))
+	}
+	if v, ok := d.GetOk("width"); ok {
+		optionalParams.WithWidth(int64(v.(int)))
+	}
+
+	snapshot, httpresp, err := apiInstances.GetSnapshotsApiV1().GetGraphSnapshot(auth, start, end, *optionalParams)
+	if err != nil {
+		return utils.TranslateClientErrorDiag(err, httpresp, "error taking graph snapshot")
+	}
+	if err := utils.CheckForUnparsed(snapshot); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.ConvertToSha256(snapshot.GetSnapshotUrl()))
+	d.Set("start", int(start))
+	d.Set("end", int(end))
+	d.Set("graph_def", graphDef)
+	d.Set("snapshot_url", snapshot.GetSnapshotUrl())
+
+	return nil
+}
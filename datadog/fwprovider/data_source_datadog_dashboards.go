@@ -0,0 +1,307 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+)
+
+var (
+	_ datasource.DataSource = &datadogDashboardsDataSource{}
+)
+
+type DashboardModel struct {
+	AuthorHandle types.String `tfsdk:"author_handle"`
+	ID           types.String `tfsdk:"id"`
+	LayoutType   types.String `tfsdk:"layout_type"`
+	Tags         types.List   `tfsdk:"tags"`
+	Title        types.String `tfsdk:"title"`
+	URL          types.String `tfsdk:"url"`
+}
+
+type datadogDashboardsDataSourceModel struct {
+	// Query Parameters
+	FilterTitle           types.String   `tfsdk:"filter_title"`
+	FilterAuthor          types.String   `tfsdk:"filter_author"`
+	FilterTags            []types.String `tfsdk:"filter_tags"`
+	FilterShared          types.Bool     `tfsdk:"filter_shared"`
+	FilterDashboardListID types.String   `tfsdk:"filter_dashboard_list_id"`
+	FilterInDashboardList types.Bool     `tfsdk:"filter_in_dashboard_list"`
+
+	// Results
+	ID         types.String      `tfsdk:"id"`
+	Dashboards []*DashboardModel `tfsdk:"dashboards"`
+}
+
+type datadogDashboardsDataSource struct {
+	Api        *datadogV1.DashboardsApi
+	ListsApiV1 *datadogV1.DashboardListsApi
+	ListsApiV2 *datadogV2.DashboardListsApi
+	Auth       context.Context
+}
+
+func NewDatadogDashboardsDataSource() datasource.DataSource {
+	return &datadogDashboardsDataSource{}
+}
+
+func (d *datadogDashboardsDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	providerData, _ := request.ProviderData.(*FrameworkProvider)
+	d.Api = providerData.DatadogApiInstances.GetDashboardsApiV1()
+	d.ListsApiV1 = providerData.DatadogApiInstances.GetDashboardListsApiV1()
+	d.ListsApiV2 = providerData.DatadogApiInstances.GetDashboardListsApiV2()
+	d.Auth = providerData.Auth
+}
+
+func (d *datadogDashboardsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "dashboards"
+}
+
+func (d *datadogDashboardsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Use this data source to list existing dashboards matching a set of filters, for use in other resources. In particular, it can be used to find dashboards that don't belong to any dashboard list.",
+		Attributes: map[string]schema.Attribute{
+			"id": utils.ResourceIDAttribute(),
+			"filter_title": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return dashboards whose title contains this string. The match is case-insensitive.",
+			},
+			"filter_author": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return dashboards created by the user with this handle.",
+			},
+			"filter_tags": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return dashboards that have all of these tags. A `*` wildcard can be used to match any value, for example `team:*`. Listing dashboards doesn't return their tags, so every dashboard matching the other filters is read individually: combine it with the other filters to limit the number of requests.",
+			},
+			"filter_shared": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, only returns shared custom created or cloned dashboards.",
+			},
+			"filter_dashboard_list_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return dashboards that belong to the dashboard list with this ID. Conflicts with `filter_in_dashboard_list`.",
+				Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("filter_in_dashboard_list"))},
+			},
+			"filter_in_dashboard_list": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, only returns dashboards that belong to at least one dashboard list. When false, only returns dashboards that don't belong to any dashboard list. Conflicts with `filter_dashboard_list_id`.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"dashboards": schema.ListNestedBlock{
+				Description: "List of dashboards matching the filters.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"author_handle": schema.StringAttribute{
+							Computed:    true,
+							Description: "Identifier of the dashboard author.",
+						},
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The dashboard's identifier.",
+						},
+						"layout_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The layout type of the dashboard.",
+						},
+						"tags": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Tags of the dashboard. Only set when `filter_tags` is set, as listing dashboards doesn't return their tags and reading every dashboard would be too costly.",
+						},
+						"title": schema.StringAttribute{
+							Computed:    true,
+							Description: "The title of the dashboard.",
+						},
+						"url": schema.StringAttribute{
+							Computed:    true,
+							Description: "The URL of the dashboard.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *datadogDashboardsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var state datadogDashboardsDataSourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var optionalParams datadogV1.ListDashboardsOptionalParameters
+	if !state.FilterShared.IsNull() {
+		optionalParams.FilterShared = state.FilterShared.ValueBoolPointer()
+	}
+
+	var summaries []datadogV1.DashboardSummaryDefinition
+	result, _ := d.Api.ListDashboardsWithPagination(d.Auth, optionalParams)
+	for paginationResult := range result {
+		if paginationResult.Error != nil {
+			response.Diagnostics.Append(utils.FrameworkErrorDiag(paginationResult.Error, "Error when calling `ListDashboardsWithPagination`"))
+			return
+		}
+
+		summaries = append(summaries, paginationResult.Item)
+	}
+
+	listMembership, diags := d.getDashboardListMembership(&state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	titleFilter := strings.ToLower(state.FilterTitle.ValueString())
+	var tagMatchers []*regexp.Regexp
+	for _, tag := range state.FilterTags {
+		tagMatchers = append(tagMatchers, utils.TagMatcher(tag.ValueString()))
+	}
+
+	dashboards := make([]datadogV1.DashboardSummaryDefinition, 0)
+	// Tags are only returned by the single dashboard endpoint, dashboards are only fetched when filtering on tags
+	var dashboardTags map[string][]string
+	if len(tagMatchers) > 0 {
+		dashboardTags = make(map[string][]string)
+	}
+	for _, summary := range summaries {
+		if titleFilter != "" && !strings.Contains(strings.ToLower(summary.GetTitle()), titleFilter) {
+			continue
+		}
+		if !state.FilterAuthor.IsNull() && summary.GetAuthorHandle() != state.FilterAuthor.ValueString() {
+			continue
+		}
+		if listMembership != nil {
+			_, inList := listMembership[summary.GetId()]
+			wantInList := state.FilterInDashboardList.IsNull() || state.FilterInDashboardList.ValueBool()
+			if inList != wantInList {
+				continue
+			}
+		}
+
+		if dashboardTags != nil {
+			dashboard, httpresp, err := d.Api.GetDashboard(d.Auth, summary.GetId())
+			if err != nil {
+				if httpresp != nil && httpresp.StatusCode == 404 {
+					// The dashboard was deleted since it was listed
+					continue
+				}
+				response.Diagnostics.Append(utils.FrameworkErrorDiag(utils.TranslateClientError(err, httpresp, ""), "error getting dashboard "+summary.GetId()))
+				return
+			}
+			if !utils.TagsMatch(dashboard.GetTags(), tagMatchers) {
+				continue
+			}
+			dashboardTags[summary.GetId()] = dashboard.GetTags()
+		}
+
+		dashboards = append(dashboards, summary)
+	}
+
+	response.Diagnostics.Append(d.updateState(ctx, &state, dashboards, dashboardTags)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+// getDashboardListMembership returns the set of dashboard IDs belonging to the filtered dashboard list,
+// or to any dashboard list when `filter_in_dashboard_list` is set. It returns nil when no list filter is set.
+func (d *datadogDashboardsDataSource) getDashboardListMembership(state *datadogDashboardsDataSourceModel) (map[string]struct{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if state.FilterDashboardListID.IsNull() && state.FilterInDashboardList.IsNull() {
+		return nil, diags
+	}
+
+	var listIDs []int64
+	if !state.FilterDashboardListID.IsNull() {
+		id, err := strconv.ParseInt(state.FilterDashboardListID.ValueString(), 10, 64)
+		if err != nil {
+			diags.AddError("failed to parse dashboard list id: ", err.Error())
+			return nil, diags
+		}
+		listIDs = append(listIDs, id)
+	} else {
+		lists, httpresp, err := d.ListsApiV1.ListDashboardLists(d.Auth)
+		if err != nil {
+			diags.Append(utils.FrameworkErrorDiag(utils.TranslateClientError(err, httpresp, ""), "error querying dashboard lists"))
+			return nil, diags
+		}
+		for _, list := range lists.GetDashboardLists() {
+			listIDs = append(listIDs, list.GetId())
+		}
+	}
+
+	membership := make(map[string]struct{})
+	for _, listID := range listIDs {
+		items, httpresp, err := d.ListsApiV2.GetDashboardListItems(d.Auth, listID)
+		if err != nil {
+			diags.Append(utils.FrameworkErrorDiag(utils.TranslateClientError(err, httpresp, ""), "error getting dashboard list items"))
+			return nil, diags
+		}
+		for _, item := range items.GetDashboards() {
+			membership[item.GetId()] = struct{}{}
+		}
+	}
+
+	return membership, diags
+}
+
+// updateState sets the matching dashboards in the state. Their tags are only set when known, that is when
+// filtering on tags.
+func (d *datadogDashboardsDataSource) updateState(ctx context.Context, state *datadogDashboardsDataSourceModel, dashboardsData []datadogV1.DashboardSummaryDefinition, dashboardTags map[string][]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	dashboards := make([]*DashboardModel, 0, len(dashboardsData))
+	for _, dashboard := range dashboardsData {
+		tags := types.ListNull(types.StringType)
+		if dashboardTags != nil {
+			var tagDiags diag.Diagnostics
+			tags, tagDiags = types.ListValueFrom(ctx, types.StringType, dashboardTags[dashboard.GetId()])
+			diags.Append(tagDiags...)
+		}
+
+		dashboards = append(dashboards, &DashboardModel{
+			AuthorHandle: types.StringValue(dashboard.GetAuthorHandle()),
+			ID:           types.StringValue(dashboard.GetId()),
+			LayoutType:   types.StringValue(string(dashboard.GetLayoutType())),
+			Tags:         tags,
+			Title:        types.StringValue(dashboard.GetTitle()),
+			URL:          types.StringValue(dashboard.GetUrl()),
+		})
+	}
+
+	filterTags := make([]string, 0, len(state.FilterTags))
+	for _, tag := range state.FilterTags {
+		filterTags = append(filterTags, tag.ValueString())
+	}
+	hashingData := fmt.Sprintf("%s:%s:%s:%s:%s:%s",
+		state.FilterTitle.ValueString(),
+		state.FilterAuthor.ValueString(),
+		strings.Join(filterTags, ","),
+		state.FilterShared.String(),
+		state.FilterDashboardListID.ValueString(),
+		state.FilterInDashboardList.String(),
+	)
+
+	state.ID = types.StringValue(utils.ConvertToSha256(hashingData))
+	state.Dashboards = dashboards
+	return diags
+}
@@ -0,0 +1,343 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
+)
+
+var (
+	_ datasource.DataSource = &datadogDowntimesDataSource{}
+)
+
+const downtimesPageSize = 100
+
+// downtimeScopeGroupRegex matches the grouped values of a tag in a scope, for example `env:(prod OR staging)`
+var downtimeScopeGroupRegex = regexp.MustCompile(`(-?[^\s(),:]+):\(([^)]*)\)`)
+
+type downtimeSummaryModel struct {
+	ID              types.String `tfsdk:"id"`
+	Scope           types.String `tfsdk:"scope"`
+	Status          types.String `tfsdk:"status"`
+	Message         types.String `tfsdk:"message"`
+	DisplayTimezone types.String `tfsdk:"display_timezone"`
+	MonitorID       types.Int64  `tfsdk:"monitor_id"`
+	MonitorTags     types.List   `tfsdk:"monitor_tags"`
+	Recurring       types.Bool   `tfsdk:"recurring"`
+	Start           types.String `tfsdk:"start"`
+	End             types.String `tfsdk:"end"`
+	CreatorID       types.String `tfsdk:"creator_id"`
+	CreatorHandle   types.String `tfsdk:"creator_handle"`
+}
+
+type datadogDowntimesDataSourceModel struct {
+	// Query Parameters
+	CurrentOnly       types.Bool     `tfsdk:"current_only"`
+	FilterScope       types.String   `tfsdk:"filter_scope"`
+	FilterMonitorID   types.Int64    `tfsdk:"filter_monitor_id"`
+	FilterMonitorTags []types.String `tfsdk:"filter_monitor_tags"`
+	FilterStatus      types.String   `tfsdk:"filter_status"`
+
+	// Results
+	ID        types.String            `tfsdk:"id"`
+	Downtimes []*downtimeSummaryModel `tfsdk:"downtimes"`
+}
+
+type datadogDowntimesDataSource struct {
+	Api  *datadogV2.DowntimesApi
+	Auth context.Context
+}
+
+func NewDatadogDowntimesDataSource() datasource.DataSource {
+	return &datadogDowntimesDataSource{}
+}
+
+func (d *datadogDowntimesDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	providerData, _ := request.ProviderData.(*FrameworkProvider)
+	d.Api = providerData.DatadogApiInstances.GetDowntimesApiV2()
+	d.Auth = providerData.Auth
+}
+
+func (d *datadogDowntimesDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "downtimes"
+}
+
+func (d *datadogDowntimesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Use this data source to list existing downtimes matching a set of filters, for example to check whether a maintenance window is currently in effect.",
+		Attributes: map[string]schema.Attribute{
+			"id": utils.ResourceIDAttribute(),
+			"current_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only return downtimes that are active when the data source is read.",
+			},
+			"filter_scope": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return downtimes whose scope contains all the terms of this scope, for example `env:prod`. Terms are compared as a whole, so `env:prod` doesn't match `env:production`, and grouped values such as `env:(prod OR staging)` match each of their terms.",
+			},
+			"filter_monitor_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Only return downtimes silencing the monitor with this ID.",
+			},
+			"filter_monitor_tags": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return downtimes silencing monitors by tags, with all of these tags in their monitor tags.",
+			},
+			"filter_status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return downtimes with this status.",
+				Validators:  []validator.String{validators.NewEnumValidator[validator.String](datadogV2.NewDowntimeStatusFromValue)},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"downtimes": schema.ListNestedBlock{
+				Description: "List of downtimes matching the filters.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the downtime.",
+						},
+						"scope": schema.StringAttribute{
+							Computed:    true,
+							Description: "The scope of the downtime.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the downtime, one of `active`, `canceled`, `ended` or `scheduled`.",
+						},
+						"message": schema.StringAttribute{
+							Computed:    true,
+							Description: "The message of the downtime.",
+						},
+						"display_timezone": schema.StringAttribute{
+							Computed:    true,
+							Description: "The timezone in which the downtime's start and end times are displayed in Datadog applications.",
+						},
+						"monitor_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "ID of the monitor silenced by the downtime. Null when the downtime silences monitors by tags.",
+						},
+						"monitor_tags": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Tags of the monitors silenced by the downtime. Null when the downtime silences a single monitor.",
+						},
+						"recurring": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the downtime has a recurring schedule.",
+						},
+						"start": schema.StringAttribute{
+							Computed:    true,
+							Description: "Start of the downtime, in RFC 3339 format. For recurring downtimes, this is the start of the current or next occurrence.",
+						},
+						"end": schema.StringAttribute{
+							Computed:    true,
+							Description: "End of the downtime, in RFC 3339 format. For recurring downtimes, this is the end of the current or next occurrence. Null when the downtime doesn't end.",
+						},
+						"creator_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the user who created the downtime.",
+						},
+						"creator_handle": schema.StringAttribute{
+							Computed:    true,
+							Description: "Handle of the user who created the downtime.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *datadogDowntimesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var state datadogDowntimesDataSourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	optionalParams := datadogV2.NewListDowntimesOptionalParameters().
+		WithInclude("created_by").
+		WithPageLimit(downtimesPageSize)
+	if !state.CurrentOnly.IsNull() {
+		optionalParams.WithCurrentOnly(state.CurrentOnly.ValueBool())
+	}
+
+	var downtimes []datadogV2.DowntimeResponseData
+	creators := map[string]string{}
+	for offset := int64(0); ; offset += downtimesPageSize {
+		optionalParams.WithPageOffset(offset)
+		ddResp, _, err := d.Api.ListDowntimes(d.Auth, *optionalParams)
+		if err != nil {
+			response.Diagnostics.Append(utils.FrameworkErrorDiag(err, "error listing downtimes"))
+			return
+		}
+
+		for _, included := range ddResp.GetIncluded() {
+			if user := included.User; user != nil {
+				attributes := user.GetAttributes()
+				creators[user.GetId()] = attributes.GetHandle()
+			}
+		}
+		for _, downtime := range ddResp.GetData() {
+			if downtimeMatchesFilters(&state, downtime) {
+				downtimes = append(downtimes, downtime)
+			}
+		}
+		if len(ddResp.GetData()) < downtimesPageSize {
+			break
+		}
+	}
+
+	response.Diagnostics.Append(d.updateState(ctx, &state, downtimes, creators)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func downtimeMatchesFilters(state *datadogDowntimesDataSourceModel, downtime datadogV2.DowntimeResponseData) bool {
+	attributes := downtime.GetAttributes()
+	if !state.FilterScope.IsNull() {
+		terms := downtimeScopeTerms(attributes.GetScope())
+		for _, term := range downtimeScopeTerms(state.FilterScope.ValueString()) {
+			if !slices.Contains(terms, term) {
+				return false
+			}
+		}
+	}
+	if !state.FilterStatus.IsNull() && string(attributes.GetStatus()) != state.FilterStatus.ValueString() {
+		return false
+	}
+
+	identifier := attributes.GetMonitorIdentifier()
+	if !state.FilterMonitorID.IsNull() {
+		if identifier.DowntimeMonitorIdentifierId == nil || identifier.DowntimeMonitorIdentifierId.GetMonitorId() != state.FilterMonitorID.ValueInt64() {
+			return false
+		}
+	}
+	if len(state.FilterMonitorTags) > 0 {
+		if identifier.DowntimeMonitorIdentifierTags == nil {
+			return false
+		}
+		monitorTags := identifier.DowntimeMonitorIdentifierTags.GetMonitorTags()
+		for _, tag := range state.FilterMonitorTags {
+			if !slices.Contains(monitorTags, tag.ValueString()) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// downtimeScopeTerms returns the terms of a scope, without the boolean operators. Grouped values are expanded, so
+// that `env:(prod OR staging)` returns `env:prod` and `env:staging`.
+func downtimeScopeTerms(scope string) []string {
+	scope = downtimeScopeGroupRegex.ReplaceAllStringFunc(scope, func(group string) string {
+		match := downtimeScopeGroupRegex.FindStringSubmatch(group)
+		terms := make([]string, 0)
+		for _, value := range downtimeScopeFields(match[2]) {
+			terms = append(terms, match[1]+":"+value)
+		}
+		return strings.Join(terms, " ")
+	})
+	return downtimeScopeFields(scope)
+}
+
+func downtimeScopeFields(scope string) []string {
+	fields := strings.FieldsFunc(scope, func(r rune) bool {
+		return r == ' ' || r == ',' || r == '(' || r == ')'
+	})
+	return slices.DeleteFunc(fields, func(field string) bool {
+		return field == "AND" || field == "OR" || field == "NOT"
+	})
+}
+
+func (d *datadogDowntimesDataSource) updateState(ctx context.Context, state *datadogDowntimesDataSourceModel, downtimesData []datadogV2.DowntimeResponseData, creators map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	downtimes := make([]*downtimeSummaryModel, 0, len(downtimesData))
+	for _, downtime := range downtimesData {
+		attributes := downtime.GetAttributes()
+		relationships := downtime.GetRelationships()
+		createdBy := relationships.GetCreatedBy()
+		creator := createdBy.GetData()
+
+		summary := &downtimeSummaryModel{
+			ID:              types.StringValue(downtime.GetId()),
+			Scope:           types.StringValue(attributes.GetScope()),
+			Status:          types.StringValue(string(attributes.GetStatus())),
+			Message:         types.StringValue(attributes.GetMessage()),
+			DisplayTimezone: types.StringValue(attributes.GetDisplayTimezone()),
+			MonitorID:       types.Int64Null(),
+			MonitorTags:     types.ListNull(types.StringType),
+			Recurring:       types.BoolValue(false),
+			Start:           types.StringNull(),
+			End:             types.StringNull(),
+			CreatorID:       types.StringValue(creator.GetId()),
+			CreatorHandle:   types.StringValue(creators[creator.GetId()]),
+		}
+
+		identifier := attributes.GetMonitorIdentifier()
+		if identifier.DowntimeMonitorIdentifierId != nil {
+			summary.MonitorID = types.Int64Value(identifier.DowntimeMonitorIdentifierId.GetMonitorId())
+		}
+		if identifier.DowntimeMonitorIdentifierTags != nil {
+			monitorTags, tagDiags := types.ListValueFrom(ctx, types.StringType, identifier.DowntimeMonitorIdentifierTags.GetMonitorTags())
+			diags.Append(tagDiags...)
+			summary.MonitorTags = monitorTags
+		}
+
+		schedule := attributes.GetSchedule()
+		if oneTime := schedule.DowntimeScheduleOneTimeResponse; oneTime != nil {
+			summary.Start = types.StringValue(oneTime.GetStart().Format(time.RFC3339))
+			if end, ok := oneTime.GetEndOk(); ok && end != nil {
+				summary.End = types.StringValue(end.Format(time.RFC3339))
+			}
+		}
+		if recurring := schedule.DowntimeScheduleRecurrencesResponse; recurring != nil {
+			summary.Recurring = types.BoolValue(true)
+			current := recurring.GetCurrentDowntime()
+			if start, ok := current.GetStartOk(); ok {
+				summary.Start = types.StringValue(start.Format(time.RFC3339))
+			}
+			if end, ok := current.GetEndOk(); ok && end != nil {
+				summary.End = types.StringValue(end.Format(time.RFC3339))
+			}
+		}
+
+		downtimes = append(downtimes, summary)
+	}
+
+	filterMonitorTags := make([]string, 0, len(state.FilterMonitorTags))
+	for _, tag := range state.FilterMonitorTags {
+		filterMonitorTags = append(filterMonitorTags, tag.ValueString())
+	}
+	hashingData := fmt.Sprintf("%t:%s:%d:%s:%s",
+		state.CurrentOnly.ValueBool(),
+		state.FilterScope.ValueString(),
+		state.FilterMonitorID.ValueInt64(),
+		strings.Join(filterMonitorTags, ","),
+		state.FilterStatus.ValueString(),
+	)
+
+	state.ID = types.StringValue(utils.ConvertToSha256(hashingData))
+	state.Downtimes = downtimes
+	return diags
+}
@@ -0,0 +1,263 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/eventquery"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
+)
+
+var (
+	_ datasource.DataSource = &datadogOnCallRoutingSimulationDataSource{}
+)
+
+type datadogOnCallRoutingSimulationModel struct {
+	// Query Parameters
+	TeamID    types.String      `tfsdk:"team_id"`
+	Tags      []types.String    `tfsdk:"tags"`
+	Priority  types.String      `tfsdk:"priority"`
+	Timestamp timetypes.RFC3339 `tfsdk:"timestamp"`
+
+	// Results
+	ID               types.String `tfsdk:"id"`
+	Matched          types.Bool   `tfsdk:"matched"`
+	RuleID           types.String `tfsdk:"rule_id"`
+	RuleIndex        types.Int64  `tfsdk:"rule_index"`
+	RuleQuery        types.String `tfsdk:"rule_query"`
+	Urgency          types.String `tfsdk:"urgency"`
+	EscalationPolicy types.String `tfsdk:"escalation_policy"`
+}
+
+type datadogOnCallRoutingSimulationDataSource struct {
+	Api  *datadogV2.OnCallApi
+	Auth context.Context
+	Now  func() time.Time
+}
+
+func NewDatadogOnCallRoutingSimulationDataSource() datasource.DataSource {
+	return &datadogOnCallRoutingSimulationDataSource{}
+}
+
+func (d *datadogOnCallRoutingSimulationDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	providerData, _ := request.ProviderData.(*FrameworkProvider)
+	d.Api = providerData.DatadogApiInstances.GetOnCallApiV2()
+	d.Auth = providerData.Auth
+	d.Now = providerData.Now
+}
+
+func (d *datadogOnCallRoutingSimulationDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "on_call_routing_simulation"
+}
+
+func (d *datadogOnCallRoutingSimulationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Use this data source to simulate how the routing rules of a Datadog On-Call team handle a sample alert. The rules are evaluated locally and in order, and the first rule whose `time_restrictions` and `query` match the alert is returned, so routing changes can be tested with `terraform test` without paging anybody. Queries are evaluated on the `priority`, `tags` and `tags.<key>` attributes only.",
+		Attributes: map[string]schema.Attribute{
+			"id": utils.ResourceIDAttribute(),
+			"team_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the team whose routing rules are evaluated.",
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Tags of the sample alert, in `key:value` form.",
+			},
+			"priority": schema.StringAttribute{
+				Optional:    true,
+				Description: "Priority of the sample alert.",
+				Validators:  []validator.String{stringvalidator.OneOf("P1", "P2", "P3", "P4", "P5")},
+			},
+			"timestamp": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Optional:    true,
+				Description: "The date/time at which the sample alert triggers (in ISO 8601), used to evaluate the `time_restrictions` of the rules. Defaults to the time the data source is read.",
+				Validators:  []validator.String{validators.TimeFormatValidator(time.RFC3339)},
+			},
+			// Computed values
+			"matched": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether a routing rule matches the sample alert.",
+			},
+			"rule_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the matching routing rule.",
+			},
+			"rule_index": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Index of the matching routing rule in the `rule` list of `datadog_on_call_team_routing_rules`, starting from 0.",
+			},
+			"rule_query": schema.StringAttribute{
+				Computed:    true,
+				Description: "Query of the matching routing rule.",
+			},
+			"urgency": schema.StringAttribute{
+				Computed:    true,
+				Description: "Urgency of the pages created for the sample alert. A `dynamic` urgency is resolved to `high` for `P1` and `P2` alerts, and to `low` otherwise.",
+			},
+			"escalation_policy": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the escalation policy applied to the sample alert.",
+			},
+		},
+	}
+}
+
+func (d *datadogOnCallRoutingSimulationDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var state datadogOnCallRoutingSimulationModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	at := d.Now()
+	if !state.Timestamp.IsNull() {
+		var diags diag.Diagnostics
+		at, diags = state.Timestamp.ValueRFC3339Time()
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	include := "rules"
+	resp, _, err := d.Api.GetOnCallTeamRoutingRules(d.Auth, state.TeamID.ValueString(), datadogV2.GetOnCallTeamRoutingRulesOptionalParameters{
+		Include: &include,
+	})
+	if err != nil {
+		response.Diagnostics.Append(utils.FrameworkErrorDiag(err, "error retrieving OnCallTeamRoutingRules"))
+		return
+	}
+	if err := utils.CheckForUnparsed(resp); err != nil {
+		response.Diagnostics.AddError("response contains unparsed object", err.Error())
+		return
+	}
+	rules := (&onCallTeamRoutingRulesResource{}).stateFromResponse(&resp).Rules
+
+	event := eventquery.Event{Priority: state.Priority.ValueString()}
+	for _, tag := range state.Tags {
+		event.Tags = append(event.Tags, tag.ValueString())
+	}
+
+	state.ID = types.StringValue(utils.ConvertToSha256(fmt.Sprintf("%s:%s:%s:%s", state.TeamID.ValueString(), strings.Join(event.Tags, ","), event.Priority, state.Timestamp.ValueString())))
+	state.Matched = types.BoolValue(false)
+	state.RuleID = types.StringNull()
+	state.RuleIndex = types.Int64Null()
+	state.RuleQuery = types.StringNull()
+	state.Urgency = types.StringNull()
+	state.EscalationPolicy = types.StringNull()
+
+	for i, rule := range rules {
+		active, err := onCallRoutingRuleActive(rule.TimeRestrictions, at)
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("team_id"), fmt.Sprintf("invalid time restrictions in routing rule %d", i), err.Error())
+			return
+		}
+		if !active {
+			continue
+		}
+		query, err := eventquery.Parse(rule.Query.ValueString())
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("team_id"), fmt.Sprintf("routing rule %d can't be evaluated locally", i), err.Error())
+			return
+		}
+		if !query.Matches(event) {
+			continue
+		}
+
+		state.Matched = types.BoolValue(true)
+		state.RuleID = rule.Id
+		state.RuleIndex = types.Int64Value(int64(i))
+		state.RuleQuery = rule.Query
+		state.EscalationPolicy = rule.EscalationPolicy
+		state.Urgency = rule.Urgency
+		if rule.Urgency.ValueString() == string(datadogV2.URGENCY_DYNAMIC) {
+			state.Urgency = types.StringValue(onCallDynamicUrgency(event.Priority))
+		}
+		break
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+// onCallDynamicUrgency resolves a dynamic urgency from the priority of an alert.
+func onCallDynamicUrgency(priority string) string {
+	if priority == "P1" || priority == "P2" {
+		return string(datadogV2.URGENCY_HIGH)
+	}
+	return string(datadogV2.URGENCY_LOW)
+}
+
+// onCallRoutingRuleActive reports whether t is within the time restrictions of a routing rule, which is always
+// active when it has none. Restrictions without days apply every day.
+func onCallRoutingRuleActive(timeRestrictions *teamTimeRestrictionsModel, t time.Time) (bool, error) {
+	if timeRestrictions == nil || len(timeRestrictions.Restrictions) == 0 {
+		return true, nil
+	}
+	loc, err := time.LoadLocation(timeRestrictions.TimeZone.ValueString())
+	if err != nil {
+		return false, err
+	}
+	local := t.In(loc)
+
+	for _, restriction := range timeRestrictions.Restrictions {
+		startTime, endTime := restriction.StartTime.ValueString(), restriction.EndTime.ValueString()
+		if startTime == "" {
+			startTime = "00:00:00"
+		}
+		if endTime == "" {
+			endTime = "00:00:00"
+		}
+
+		startDay, endDay := restriction.StartDay.ValueString(), restriction.EndDay.ValueString()
+		if startDay == "" && endDay == "" {
+			// Daily restrictions are checked against the time of day only
+			start, startErr := time.Parse("15:04:05", startTime)
+			end, endErr := time.Parse("15:04:05", endTime)
+			if startErr != nil || endErr != nil {
+				return false, fmt.Errorf("invalid restriction from %s to %s", startTime, endTime)
+			}
+			if onCallInDailyRestriction(start, end, local) {
+				return true, nil
+			}
+			continue
+		}
+
+		start, startOk := onCallWeekOffset(startDay, startTime)
+		end, endOk := onCallWeekOffset(endDay, endTime)
+		if !startOk || !endOk {
+			return false, fmt.Errorf("invalid restriction from %s %s to %s %s", startDay, startTime, endDay, endTime)
+		}
+		if onCallInRestrictions([][2]int{{start, end}}, local) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// onCallInDailyRestriction reports whether the time of day of t is within [start, end), wrapping around midnight when
+// the restriction ends before it starts.
+func onCallInDailyRestriction(start, end time.Time, t time.Time) bool {
+	offset := t.Hour()*60*60 + t.Minute()*60 + t.Second()
+	from := start.Hour()*60*60 + start.Minute()*60 + start.Second()
+	until := end.Hour()*60*60 + end.Minute()*60 + end.Second()
+	if from < until {
+		return offset >= from && offset < until
+	}
+	return offset >= from || offset < until
+}
@@ -0,0 +1,163 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
+)
+
+var (
+	_ datasource.DataSource = &datadogOnCallScheduleOnCallUserDataSource{}
+)
+
+type datadogOnCallScheduleOnCallUserModel struct {
+	// Query Parameters
+	ScheduleID types.String      `tfsdk:"schedule_id"`
+	At         timetypes.RFC3339 `tfsdk:"at"`
+
+	// Results
+	ID         types.String `tfsdk:"id"`
+	UserID     types.String `tfsdk:"user_id"`
+	UserEmail  types.String `tfsdk:"user_email"`
+	UserName   types.String `tfsdk:"user_name"`
+	ShiftStart types.String `tfsdk:"shift_start"`
+	ShiftEnd   types.String `tfsdk:"shift_end"`
+}
+
+type datadogOnCallScheduleOnCallUserDataSource struct {
+	Api  *datadogV2.OnCallApi
+	Auth context.Context
+}
+
+func NewDatadogOnCallScheduleOnCallUserDataSource() datasource.DataSource {
+	return &datadogOnCallScheduleOnCallUserDataSource{}
+}
+
+func (d *datadogOnCallScheduleOnCallUserDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	providerData, _ := request.ProviderData.(*FrameworkProvider)
+	d.Api = providerData.DatadogApiInstances.GetOnCallApiV2()
+	d.Auth = providerData.Auth
+}
+
+func (d *datadogOnCallScheduleOnCallUserDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "on_call_schedule_on_call_user"
+}
+
+func (d *datadogOnCallScheduleOnCallUserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Use this data source to retrieve the user who is on call for a Datadog On-Call schedule at a given time.",
+		Attributes: map[string]schema.Attribute{
+			"id": utils.ResourceIDAttribute(),
+			"schedule_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the On-Call schedule.",
+			},
+			"at": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Optional:    true,
+				Description: "The date/time at which to retrieve the on-call user (in ISO 8601). Defaults to the time the data source is read.",
+				Validators:  []validator.String{validators.TimeFormatValidator(time.RFC3339)},
+			},
+			// Computed values
+			"user_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the on-call user. Null when nobody is on call.",
+			},
+			"user_email": schema.StringAttribute{
+				Computed:    true,
+				Description: "Email of the on-call user.",
+			},
+			"user_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the on-call user.",
+			},
+			"shift_start": schema.StringAttribute{
+				Computed:    true,
+				Description: "Start of the shift of the on-call user, in RFC 3339 format.",
+			},
+			"shift_end": schema.StringAttribute{
+				Computed:    true,
+				Description: "End of the shift of the on-call user, in RFC 3339 format.",
+			},
+		},
+	}
+}
+
+func (d *datadogOnCallScheduleOnCallUserDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var state datadogOnCallScheduleOnCallUserModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	optionalParams := datadogV2.NewGetScheduleOnCallUserOptionalParameters().WithInclude("user")
+	if !state.At.IsNull() {
+		at, diags := state.At.ValueRFC3339Time()
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		optionalParams.WithFilterAtTs(at.UTC().Format(time.RFC3339))
+	}
+
+	resp, _, err := d.Api.GetScheduleOnCallUser(d.Auth, state.ScheduleID.ValueString(), *optionalParams)
+	if err != nil {
+		response.Diagnostics.Append(utils.FrameworkErrorDiag(err, "error getting on-call user"))
+		return
+	}
+	if err := utils.CheckForUnparsed(resp); err != nil {
+		response.Diagnostics.AddError("response contains unparsed object", err.Error())
+		return
+	}
+
+	d.updateState(&state, &resp)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (d *datadogOnCallScheduleOnCallUserDataSource) updateState(state *datadogOnCallScheduleOnCallUserModel, resp *datadogV2.Shift) {
+	state.ID = types.StringValue(utils.ConvertToSha256(fmt.Sprintf("%s:%s", state.ScheduleID.ValueString(), state.At.ValueString())))
+	state.UserID = types.StringNull()
+	state.UserEmail = types.StringNull()
+	state.UserName = types.StringNull()
+	state.ShiftStart = types.StringNull()
+	state.ShiftEnd = types.StringNull()
+
+	shift, ok := resp.GetDataOk()
+	if !ok {
+		return
+	}
+	attributes := shift.GetAttributes()
+	if start, ok := attributes.GetStartOk(); ok {
+		state.ShiftStart = types.StringValue(start.Format(time.RFC3339))
+	}
+	if end, ok := attributes.GetEndOk(); ok {
+		state.ShiftEnd = types.StringValue(end.Format(time.RFC3339))
+	}
+
+	relationships := shift.GetRelationships()
+	user, ok := relationships.GetUserOk()
+	if !ok || user.Data.GetId() == "" {
+		return
+	}
+	userID := user.Data.GetId()
+	state.UserID = types.StringValue(userID)
+	for _, included := range resp.GetIncluded() {
+		if included.ScheduleUser == nil || included.ScheduleUser.GetId() != userID {
+			continue
+		}
+		userAttributes := included.ScheduleUser.GetAttributes()
+		state.UserEmail = types.StringValue(userAttributes.GetEmail())
+		state.UserName = types.StringValue(userAttributes.GetName())
+	}
+}
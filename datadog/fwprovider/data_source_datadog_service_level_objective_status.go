@@ -0,0 +1,253 @@
+package fwprovider
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+)
+
+var (
+	_ datasource.DataSource = &datadogServiceLevelObjectiveStatusDataSource{}
+)
+
+// Length of the rolling windows of the SLO timeframes. Custom timeframes don't have a status.
+var sloTimeframeDays = map[datadogV1.SLOTimeframe]int64{
+	datadogV1.SLOTIMEFRAME_SEVEN_DAYS:  7,
+	datadogV1.SLOTIMEFRAME_THIRTY_DAYS: 30,
+	datadogV1.SLOTIMEFRAME_NINETY_DAYS: 90,
+}
+
+type sloStatusGroupModel struct {
+	Group                types.String  `tfsdk:"group"`
+	SliValue             types.Float64 `tfsdk:"sli_value"`
+	ErrorBudgetRemaining types.Float64 `tfsdk:"error_budget_remaining"`
+	BurnRate             types.Float64 `tfsdk:"burn_rate"`
+	BudgetExhausted      types.Bool    `tfsdk:"budget_exhausted"`
+}
+
+type sloStatusTimeframeModel struct {
+	Timeframe            types.String           `tfsdk:"timeframe"`
+	Target               types.Float64          `tfsdk:"target"`
+	SliValue             types.Float64          `tfsdk:"sli_value"`
+	ErrorBudgetRemaining types.Float64          `tfsdk:"error_budget_remaining"`
+	BurnRate             types.Float64          `tfsdk:"burn_rate"`
+	BudgetExhausted      types.Bool             `tfsdk:"budget_exhausted"`
+	Groups               []*sloStatusGroupModel `tfsdk:"groups"`
+}
+
+type datadogServiceLevelObjectiveStatusModel struct {
+	// Query Parameters
+	SloID types.String `tfsdk:"slo_id"`
+
+	// Results
+	ID                   types.String               `tfsdk:"id"`
+	Name                 types.String               `tfsdk:"name"`
+	Type                 types.String               `tfsdk:"type"`
+	Timeframes           []*sloStatusTimeframeModel `tfsdk:"timeframes"`
+	BudgetExhausted      types.Bool                 `tfsdk:"budget_exhausted"`
+	ErrorBudgetRemaining types.Float64              `tfsdk:"error_budget_remaining"`
+}
+
+type datadogServiceLevelObjectiveStatusDataSource struct {
+	Api  *datadogV1.ServiceLevelObjectivesApi
+	Auth context.Context
+	Now  func() time.Time
+}
+
+func NewDatadogServiceLevelObjectiveStatusDataSource() datasource.DataSource {
+	return &datadogServiceLevelObjectiveStatusDataSource{}
+}
+
+func (d *datadogServiceLevelObjectiveStatusDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	providerData, _ := request.ProviderData.(*FrameworkProvider)
+	d.Api = providerData.DatadogApiInstances.GetServiceLevelObjectivesApiV1()
+	d.Auth = providerData.Auth
+	d.Now = providerData.Now
+}
+
+func (d *datadogServiceLevelObjectiveStatusDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "service_level_objective_status"
+}
+
+func (d *datadogServiceLevelObjectiveStatusDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	sliValue := schema.Float64Attribute{
+		Computed:    true,
+		Description: "Current SLI value over the timeframe, in percent. Null when there is no data.",
+	}
+	errorBudgetRemaining := schema.Float64Attribute{
+		Computed:    true,
+		Description: "Remaining error budget over the timeframe, in percent of the error budget. Negative when the budget is exceeded.",
+	}
+	burnRate := schema.Float64Attribute{
+		Computed:    true,
+		Description: "Average burn rate over the whole timeframe, that is the fraction of the error budget consumed so far, `1` meaning the budget is exactly consumed. It isn't the burn rate over the short and long windows evaluated by burn rate alerts, and recent spikes are averaged out by the rest of the timeframe.",
+	}
+	budgetExhausted := schema.BoolAttribute{
+		Computed:    true,
+		Description: "Whether the error budget is exhausted over the timeframe.",
+	}
+
+	response.Schema = schema.Schema{
+		Description: "Use this data source to retrieve the current SLI value and error budget of a service level objective for each of its `thresholds` timeframes, for example to block deployments when the error budget is exhausted. Thresholds with a `custom` timeframe are ignored.",
+		Attributes: map[string]schema.Attribute{
+			"id": utils.ResourceIDAttribute(),
+			"slo_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the service level objective.",
+			},
+			// Computed values
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the service level objective.",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of the service level objective.",
+			},
+			"budget_exhausted": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the error budget is exhausted for any of the timeframes, overall or for any group.",
+			},
+			"error_budget_remaining": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Lowest remaining error budget across the timeframes, overall or for any group, in percent of the error budget. Null when there is no data.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeframes": schema.ListNestedBlock{
+				Description: "Status of the service level objective for each of its thresholds, in the order of the thresholds.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"timeframe": schema.StringAttribute{
+							Computed:    true,
+							Description: "The timeframe of the threshold.",
+						},
+						"target": schema.Float64Attribute{
+							Computed:    true,
+							Description: "The target of the threshold.",
+						},
+						"sli_value":              sliValue,
+						"error_budget_remaining": errorBudgetRemaining,
+						"burn_rate":              burnRate,
+						"budget_exhausted":       budgetExhausted,
+					},
+					Blocks: map[string]schema.Block{
+						"groups": schema.ListNestedBlock{
+							Description: "Status of each group of the service level objective, for monitor SLOs with `groups`, sorted by group.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"group": schema.StringAttribute{
+										Computed:    true,
+										Description: "Name of the group.",
+									},
+									"sli_value":              sliValue,
+									"error_budget_remaining": errorBudgetRemaining,
+									"burn_rate":              burnRate,
+									"budget_exhausted":       budgetExhausted,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *datadogServiceLevelObjectiveStatusDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var state datadogServiceLevelObjectiveStatusModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.SloID.ValueString()
+	sloResp, httpResp, err := d.Api.GetSLO(d.Auth, id)
+	if err != nil {
+		response.Diagnostics.Append(utils.FrameworkErrorDiag(utils.TranslateClientError(err, httpResp, ""), "error getting service level objective"))
+		return
+	}
+	slo := sloResp.GetData()
+
+	to := d.Now().Unix()
+	timeframes := make([]*sloStatusTimeframeModel, 0)
+	for _, threshold := range slo.GetThresholds() {
+		days, ok := sloTimeframeDays[threshold.GetTimeframe()]
+		if !ok {
+			continue
+		}
+		history, httpResp, err := d.Api.GetSLOHistory(d.Auth, id, to-days*24*60*60, to)
+		if err != nil {
+			response.Diagnostics.Append(utils.FrameworkErrorDiag(utils.TranslateClientError(err, httpResp, ""), "error getting service level objective history"))
+			return
+		}
+		if err := utils.CheckForUnparsed(history); err != nil {
+			response.Diagnostics.AddError("response contains unparsed object", err.Error())
+			return
+		}
+
+		data := history.GetData()
+		overall := data.GetOverall()
+		timeframe := &sloStatusTimeframeModel{
+			Timeframe: types.StringValue(string(threshold.GetTimeframe())),
+			Target:    types.Float64Value(threshold.GetTarget()),
+			Groups:    make([]*sloStatusGroupModel, 0),
+		}
+		timeframe.SliValue, timeframe.ErrorBudgetRemaining, timeframe.BurnRate, timeframe.BudgetExhausted = sloErrorBudget(overall.SliValue.Get(), threshold)
+		for _, group := range data.GetGroups() {
+			groupStatus := &sloStatusGroupModel{Group: types.StringValue(group.GetGroup())}
+			groupStatus.SliValue, groupStatus.ErrorBudgetRemaining, groupStatus.BurnRate, groupStatus.BudgetExhausted = sloErrorBudget(group.SliValue.Get(), threshold)
+			timeframe.Groups = append(timeframe.Groups, groupStatus)
+		}
+		sort.Slice(timeframe.Groups, func(i, j int) bool {
+			return timeframe.Groups[i].Group.ValueString() < timeframe.Groups[j].Group.ValueString()
+		})
+		timeframes = append(timeframes, timeframe)
+	}
+
+	state.ID = types.StringValue(id)
+	state.Name = types.StringValue(slo.GetName())
+	state.Type = types.StringValue(string(slo.GetType()))
+	state.Timeframes = timeframes
+	state.BudgetExhausted = types.BoolValue(false)
+	state.ErrorBudgetRemaining = types.Float64Null()
+	for _, timeframe := range timeframes {
+		statuses := []*sloStatusGroupModel{{ErrorBudgetRemaining: timeframe.ErrorBudgetRemaining, BudgetExhausted: timeframe.BudgetExhausted}}
+		statuses = append(statuses, timeframe.Groups...)
+		for _, status := range statuses {
+			if status.BudgetExhausted.ValueBool() {
+				state.BudgetExhausted = types.BoolValue(true)
+			}
+			if !status.ErrorBudgetRemaining.IsNull() && (state.ErrorBudgetRemaining.IsNull() || status.ErrorBudgetRemaining.ValueFloat64() < state.ErrorBudgetRemaining.ValueFloat64()) {
+				state.ErrorBudgetRemaining = status.ErrorBudgetRemaining
+			}
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+// sloErrorBudget returns the SLI value, the remaining error budget in percent, the burn rate and whether the budget
+// is exhausted, for a SLI value over the timeframe of a threshold.
+func sloErrorBudget(sliValue *float64, threshold datadogV1.SLOThreshold) (types.Float64, types.Float64, types.Float64, types.Bool) {
+	if sliValue == nil {
+		return types.Float64Null(), types.Float64Null(), types.Float64Null(), types.BoolValue(false)
+	}
+	allowedErrors := 100 - threshold.GetTarget()
+	if allowedErrors <= 0 {
+		// Without error budget, any error exhausts it
+		exhausted := *sliValue < 100
+		return types.Float64Value(*sliValue), types.Float64Null(), types.Float64Null(), types.BoolValue(exhausted)
+	}
+	burnRate := (100 - *sliValue) / allowedErrors
+	errorBudgetRemaining := (1 - burnRate) * 100
+	return types.Float64Value(*sliValue), types.Float64Value(errorBudgetRemaining), types.Float64Value(burnRate), types.BoolValue(errorBudgetRemaining <= 0)
+}
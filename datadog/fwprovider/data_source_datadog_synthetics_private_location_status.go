@@ -0,0 +1,189 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+)
+
+var (
+	_ datasource.DataSource = &datadogSyntheticsPrivateLocationStatusDataSource{}
+)
+
+const (
+	// Reported by every running private location worker, tagged with its location and version
+	syntheticsPrivateLocationWorkerMetric          = "synthetics.pl.worker.running"
+	syntheticsPrivateLocationStatusDefaultLookback = 15
+)
+
+type datadogSyntheticsPrivateLocationStatusModel struct {
+	// Query Parameters
+	PrivateLocationID types.String `tfsdk:"private_location_id"`
+	LookbackMinutes   types.Int64  `tfsdk:"lookback_minutes"`
+
+	// Results
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Status         types.String `tfsdk:"status"`
+	LastHeartbeat  types.String `tfsdk:"last_heartbeat"`
+	WorkerCount    types.Int64  `tfsdk:"worker_count"`
+	WorkerVersions types.List   `tfsdk:"worker_versions"`
+}
+
+type datadogSyntheticsPrivateLocationStatusDataSource struct {
+	SyntheticsApi *datadogV1.SyntheticsApi
+	MetricsApi    *datadogV1.MetricsApi
+	Auth          context.Context
+	Now           func() time.Time
+}
+
+func NewDatadogSyntheticsPrivateLocationStatusDataSource() datasource.DataSource {
+	return &datadogSyntheticsPrivateLocationStatusDataSource{}
+}
+
+func (d *datadogSyntheticsPrivateLocationStatusDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	providerData, _ := request.ProviderData.(*FrameworkProvider)
+	d.SyntheticsApi = providerData.DatadogApiInstances.GetSyntheticsApiV1()
+	d.MetricsApi = providerData.DatadogApiInstances.GetMetricsApiV1()
+	d.Auth = providerData.Auth
+	d.Now = providerData.Now
+}
+
+func (d *datadogSyntheticsPrivateLocationStatusDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "synthetics_private_location_status"
+}
+
+func (d *datadogSyntheticsPrivateLocationStatusDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Use this data source to retrieve the health of the workers of a synthetics private location, based on the `" + syntheticsPrivateLocationWorkerMetric + "` metric they report.",
+		Attributes: map[string]schema.Attribute{
+			"id": utils.ResourceIDAttribute(),
+			"private_location_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the private location.",
+			},
+			"lookback_minutes": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Number of minutes to look back for worker heartbeats. Defaults to `%d`.", syntheticsPrivateLocationStatusDefaultLookback),
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+			},
+			// Computed values
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the private location.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "`up` when at least one worker sent a heartbeat during the lookback window, `down` otherwise.",
+			},
+			"last_heartbeat": schema.StringAttribute{
+				Computed:    true,
+				Description: "Time of the last worker heartbeat during the lookback window, in RFC 3339 format. Empty when no worker sent a heartbeat.",
+			},
+			"worker_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of workers running at the time of the last heartbeat.",
+			},
+			"worker_versions": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Sorted list of the worker versions that sent a heartbeat during the lookback window.",
+			},
+		},
+	}
+}
+
+func (d *datadogSyntheticsPrivateLocationStatusDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var state datadogSyntheticsPrivateLocationStatusModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.PrivateLocationID.ValueString()
+	privateLocation, httpResp, err := d.SyntheticsApi.GetPrivateLocation(d.Auth, id)
+	if err != nil {
+		response.Diagnostics.Append(utils.FrameworkErrorDiag(utils.TranslateClientError(err, httpResp, ""), "error getting synthetics private location"))
+		return
+	}
+
+	lookback := int64(syntheticsPrivateLocationStatusDefaultLookback)
+	if !state.LookbackMinutes.IsNull() {
+		lookback = state.LookbackMinutes.ValueInt64()
+	}
+	to := d.Now().Unix()
+	from := to - lookback*60
+	query := fmt.Sprintf("sum:%s{location:%s} by {version}", syntheticsPrivateLocationWorkerMetric, id)
+	metrics, httpResp, err := d.MetricsApi.QueryMetrics(d.Auth, from, to, query)
+	if err != nil {
+		response.Diagnostics.Append(utils.FrameworkErrorDiag(utils.TranslateClientError(err, httpResp, ""), "error querying synthetics private location workers"))
+		return
+	}
+
+	var lastHeartbeat int64
+	var workerCount float64
+	versions := make([]string, 0)
+	for _, series := range metrics.GetSeries() {
+		seriesHeartbeat, seriesCount := syntheticsPrivateLocationLastHeartbeat(series.GetPointlist())
+		if seriesHeartbeat == 0 {
+			continue
+		}
+		versions = append(versions, syntheticsPrivateLocationWorkerVersion(series.GetTagSet()))
+		// Workers of all versions report at the same interval, so only the latest heartbeats are counted
+		if seriesHeartbeat > lastHeartbeat {
+			lastHeartbeat, workerCount = seriesHeartbeat, seriesCount
+		} else if seriesHeartbeat == lastHeartbeat {
+			workerCount += seriesCount
+		}
+	}
+	sort.Strings(versions)
+
+	state.ID = types.StringValue(id)
+	state.Name = types.StringValue(privateLocation.GetName())
+	state.Status = types.StringValue("down")
+	state.LastHeartbeat = types.StringValue("")
+	if lastHeartbeat > 0 {
+		state.Status = types.StringValue("up")
+		state.LastHeartbeat = types.StringValue(time.UnixMilli(lastHeartbeat).UTC().Format(time.RFC3339))
+	}
+	state.WorkerCount = types.Int64Value(int64(workerCount))
+	workerVersions, diags := types.ListValueFrom(ctx, types.StringType, versions)
+	response.Diagnostics.Append(diags...)
+	state.WorkerVersions = workerVersions
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+// syntheticsPrivateLocationLastHeartbeat returns the timestamp, in milliseconds, and the value of the last point of a
+// series with running workers.
+func syntheticsPrivateLocationLastHeartbeat(points [][]*float64) (int64, float64) {
+	for i := len(points) - 1; i >= 0; i-- {
+		point := points[i]
+		if len(point) < 2 || point[0] == nil || point[1] == nil || *point[1] <= 0 {
+			continue
+		}
+		return int64(*point[0]), *point[1]
+	}
+	return 0, 0
+}
+
+func syntheticsPrivateLocationWorkerVersion(tagSet []string) string {
+	for _, tag := range tagSet {
+		if version, ok := strings.CutPrefix(tag, "version:"); ok {
+			return version
+		}
+	}
+	return ""
+}
@@ -0,0 +1,274 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
+)
+
+var (
+	_ datasource.DataSource = &datadogSyntheticsTestsDataSource{}
+)
+
+type syntheticsTestSummaryModel struct {
+	PublicID      types.String `tfsdk:"public_id"`
+	Name          types.String `tfsdk:"name"`
+	Type          types.String `tfsdk:"type"`
+	Subtype       types.String `tfsdk:"subtype"`
+	Status        types.String `tfsdk:"status"`
+	Tags          types.List   `tfsdk:"tags"`
+	Locations     types.List   `tfsdk:"locations"`
+	MonitorID     types.Int64  `tfsdk:"monitor_id"`
+	CreatorHandle types.String `tfsdk:"creator_handle"`
+	ExecutionRule types.String `tfsdk:"execution_rule"`
+	StartURL      types.String `tfsdk:"start_url"`
+}
+
+type datadogSyntheticsTestsDataSourceModel struct {
+	// Query Parameters
+	FilterType     types.String   `tfsdk:"filter_type"`
+	FilterSubtype  types.String   `tfsdk:"filter_subtype"`
+	FilterTags     []types.String `tfsdk:"filter_tags"`
+	FilterLocation types.String   `tfsdk:"filter_location"`
+	FilterStatus   types.String   `tfsdk:"filter_status"`
+	FilterCreator  types.String   `tfsdk:"filter_creator"`
+
+	// Results
+	ID    types.String                  `tfsdk:"id"`
+	Tests []*syntheticsTestSummaryModel `tfsdk:"tests"`
+}
+
+type datadogSyntheticsTestsDataSource struct {
+	Api  *datadogV1.SyntheticsApi
+	Auth context.Context
+}
+
+func NewDatadogSyntheticsTestsDataSource() datasource.DataSource {
+	return &datadogSyntheticsTestsDataSource{}
+}
+
+func (d *datadogSyntheticsTestsDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	providerData, _ := request.ProviderData.(*FrameworkProvider)
+	d.Api = providerData.DatadogApiInstances.GetSyntheticsApiV1()
+	d.Auth = providerData.Auth
+}
+
+func (d *datadogSyntheticsTestsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "synthetics_tests"
+}
+
+func (d *datadogSyntheticsTestsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Use this data source to list existing synthetics tests matching a set of filters, for use in other resources.",
+		Attributes: map[string]schema.Attribute{
+			"id": utils.ResourceIDAttribute(),
+			"filter_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return tests of this type.",
+				Validators:  []validator.String{validators.NewEnumValidator[validator.String](datadogV1.NewSyntheticsTestDetailsTypeFromValue)},
+			},
+			"filter_subtype": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return API tests of this subtype.",
+				Validators:  []validator.String{validators.NewEnumValidator[validator.String](datadogV1.NewSyntheticsTestDetailsSubTypeFromValue)},
+			},
+			"filter_tags": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return tests that have all of these tags. A `*` wildcard can be used to match any value, for example `team:*`.",
+			},
+			"filter_location": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return tests running from this location, for example `aws:eu-central-1`.",
+			},
+			"filter_status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return tests with this status.",
+				Validators:  []validator.String{validators.NewEnumValidator[validator.String](datadogV1.NewSyntheticsTestPauseStatusFromValue)},
+			},
+			"filter_creator": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return tests created by the user with this handle or email.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"tests": schema.ListNestedBlock{
+				Description: "List of synthetics tests matching the filters.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"public_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The public ID of the test.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the test.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the test.",
+						},
+						"subtype": schema.StringAttribute{
+							Computed:    true,
+							Description: "The subtype of the test, for API tests.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the test, either `live` or `paused`.",
+						},
+						"tags": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "A list of tags assigned to the test.",
+						},
+						"locations": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "A list of locations the test runs from.",
+						},
+						"monitor_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "ID of the monitor associated with the test.",
+						},
+						"creator_handle": schema.StringAttribute{
+							Computed:    true,
+							Description: "Handle of the user who created the test.",
+						},
+						"execution_rule": schema.StringAttribute{
+							Computed:    true,
+							Description: "Execution rule of the test in CI, either `blocking`, `non_blocking` or `skipped`. Empty when the test doesn't set one.",
+						},
+						"start_url": schema.StringAttribute{
+							Computed:    true,
+							Description: "The URL requested by HTTP API tests, or the starting URL of browser tests. Empty for other tests, such as multistep API tests, whose requests are defined in their steps.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *datadogSyntheticsTestsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var state datadogSyntheticsTestsDataSourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var tagMatchers []*regexp.Regexp
+	for _, tag := range state.FilterTags {
+		tagMatchers = append(tagMatchers, utils.TagMatcher(tag.ValueString()))
+	}
+
+	tests := make([]datadogV1.SyntheticsTestDetails, 0)
+	result, cancel := d.Api.ListTestsWithPagination(d.Auth)
+	defer cancel()
+	for paginationResult := range result {
+		if paginationResult.Error != nil {
+			response.Diagnostics.Append(utils.FrameworkErrorDiag(paginationResult.Error, "Error when calling `ListTestsWithPagination`"))
+			return
+		}
+
+		test := paginationResult.Item
+		if !state.FilterType.IsNull() && string(test.GetType()) != state.FilterType.ValueString() {
+			continue
+		}
+		if !state.FilterSubtype.IsNull() && string(test.GetSubtype()) != state.FilterSubtype.ValueString() {
+			continue
+		}
+		if !state.FilterStatus.IsNull() && string(test.GetStatus()) != state.FilterStatus.ValueString() {
+			continue
+		}
+		if !state.FilterLocation.IsNull() && !syntheticsTestRunsFrom(test, state.FilterLocation.ValueString()) {
+			continue
+		}
+		if !state.FilterCreator.IsNull() {
+			creator := test.GetCreator()
+			if creator.GetHandle() != state.FilterCreator.ValueString() && creator.GetEmail() != state.FilterCreator.ValueString() {
+				continue
+			}
+		}
+		if !utils.TagsMatch(test.GetTags(), tagMatchers) {
+			continue
+		}
+
+		tests = append(tests, test)
+	}
+
+	response.Diagnostics.Append(d.updateState(ctx, &state, tests)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func syntheticsTestRunsFrom(test datadogV1.SyntheticsTestDetails, location string) bool {
+	for _, l := range test.GetLocations() {
+		if l == location {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *datadogSyntheticsTestsDataSource) updateState(ctx context.Context, state *datadogSyntheticsTestsDataSourceModel, testsData []datadogV1.SyntheticsTestDetails) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tests := make([]*syntheticsTestSummaryModel, 0, len(testsData))
+	for _, test := range testsData {
+		tags, tagDiags := types.ListValueFrom(ctx, types.StringType, test.GetTags())
+		diags.Append(tagDiags...)
+		locations, locationDiags := types.ListValueFrom(ctx, types.StringType, test.GetLocations())
+		diags.Append(locationDiags...)
+
+		options := test.GetOptions()
+		ci := options.GetCi()
+		config := test.GetConfig()
+		request := config.GetRequest()
+		creator := test.GetCreator()
+
+		tests = append(tests, &syntheticsTestSummaryModel{
+			PublicID:      types.StringValue(test.GetPublicId()),
+			Name:          types.StringValue(test.GetName()),
+			Type:          types.StringValue(string(test.GetType())),
+			Subtype:       types.StringValue(string(test.GetSubtype())),
+			Status:        types.StringValue(string(test.GetStatus())),
+			Tags:          tags,
+			Locations:     locations,
+			MonitorID:     types.Int64Value(test.GetMonitorId()),
+			CreatorHandle: types.StringValue(creator.GetHandle()),
+			ExecutionRule: types.StringValue(string(ci.GetExecutionRule())),
+			StartURL:      types.StringValue(request.GetUrl()),
+		})
+	}
+
+	filterTags := make([]string, 0, len(state.FilterTags))
+	for _, tag := range state.FilterTags {
+		filterTags = append(filterTags, tag.ValueString())
+	}
+	hashingData := fmt.Sprintf("%s:%s:%s:%s:%s:%s",
+		state.FilterType.ValueString(),
+		state.FilterSubtype.ValueString(),
+		strings.Join(filterTags, ","),
+		state.FilterLocation.ValueString(),
+		state.FilterStatus.ValueString(),
+		state.FilterCreator.ValueString(),
+	)
+
+	state.ID = types.StringValue(utils.ConvertToSha256(hashingData))
+	state.Tests = tests
+	return diags
+}
@@ -0,0 +1,314 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+)
+
+var (
+	_ datasource.DataSource = &datadogSyntheticsUsagePlanDataSource{}
+)
+
+type syntheticsUsagePlanPlannedTestModel struct {
+	Name       types.String   `tfsdk:"name"`
+	TickEvery  types.Int64    `tfsdk:"tick_every"`
+	Locations  []types.String `tfsdk:"locations"`
+	RetryCount types.Int64    `tfsdk:"retry_count"`
+	DeviceIDs  []types.String `tfsdk:"device_ids"`
+}
+
+type syntheticsUsagePlanLocationModel struct {
+	Location                       types.String  `tfsdk:"location"`
+	TestCount                      types.Int64   `tfsdk:"test_count"`
+	RunsPerHour                    types.Float64 `tfsdk:"runs_per_hour"`
+	MaxRunsPerHour                 types.Float64 `tfsdk:"max_runs_per_hour"`
+	ConcurrentExecutionsUpperBound types.Int64   `tfsdk:"concurrent_executions_upper_bound"`
+}
+
+type datadogSyntheticsUsagePlanDataSourceModel struct {
+	// Query Parameters
+	TestIDs       []types.String                         `tfsdk:"test_ids"`
+	IncludePaused types.Bool                             `tfsdk:"include_paused"`
+	PlannedTests  []*syntheticsUsagePlanPlannedTestModel `tfsdk:"planned_test"`
+
+	// Results
+	ID                             types.String                        `tfsdk:"id"`
+	TestCount                      types.Int64                         `tfsdk:"test_count"`
+	RunsPerHour                    types.Float64                       `tfsdk:"runs_per_hour"`
+	MaxRunsPerHour                 types.Float64                       `tfsdk:"max_runs_per_hour"`
+	ConcurrentExecutionsUpperBound types.Int64                         `tfsdk:"concurrent_executions_upper_bound"`
+	Locations                      []*syntheticsUsagePlanLocationModel `tfsdk:"locations"`
+}
+
+// syntheticsUsagePlanTest holds the options of a test that drive its scheduled executions.
+type syntheticsUsagePlanTest struct {
+	locations []string
+	tickEvery int64
+	retries   int64
+	devices   int64
+}
+
+type datadogSyntheticsUsagePlanDataSource struct {
+	Api  *datadogV1.SyntheticsApi
+	Auth context.Context
+}
+
+func NewDatadogSyntheticsUsagePlanDataSource() datasource.DataSource {
+	return &datadogSyntheticsUsagePlanDataSource{}
+}
+
+func (d *datadogSyntheticsUsagePlanDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	providerData, _ := request.ProviderData.(*FrameworkProvider)
+	d.Api = providerData.DatadogApiInstances.GetSyntheticsApiV1()
+	d.Auth = providerData.Auth
+}
+
+func (d *datadogSyntheticsUsagePlanDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "synthetics_usage_plan"
+}
+
+func (d *datadogSyntheticsUsagePlanDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Use this data source to estimate the scheduled executions of synthetics tests per location, to size the on-demand concurrency cap and private locations. " +
+			"Browser and mobile tests run once per device on each of their locations. Tests without a `tick_every` only run on demand and are not counted.",
+		Attributes: map[string]schema.Attribute{
+			"id": utils.ResourceIDAttribute(),
+			"test_ids": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Public IDs of the existing tests to include. All tests are included when not set and no `planned_test` is set.",
+			},
+			"include_paused": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to include paused tests. Defaults to `false`.",
+			},
+			// Computed values
+			"test_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of scheduled tests included in the plan.",
+			},
+			"runs_per_hour": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Expected number of executions per hour across all locations, when no execution is retried.",
+			},
+			"max_runs_per_hour": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Number of executions per hour across all locations when every execution is retried as many times as allowed by the retry options.",
+			},
+			"concurrent_executions_upper_bound": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Upper bound of the number of executions running at the same time across all locations: the executions of all the tests, on each of their locations and devices, if their schedules coincided. It doesn't estimate the actual concurrency, which depends on when each test is scheduled and how long its executions take. Retries run after the execution they retry, so they don't add to it.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"planned_test": schema.ListNestedBlock{
+				Description: "Tests that don't exist yet to include in the plan.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Optional:    true,
+							Description: "Name of the test, for reference only.",
+						},
+						"tick_every": schema.Int64Attribute{
+							Required:    true,
+							Description: "How often the test runs, in seconds.",
+							Validators:  []validator.Int64{int64validator.Between(30, 604800)},
+						},
+						"locations": schema.ListAttribute{
+							Required:    true,
+							ElementType: types.StringType,
+							Description: "Locations the test runs from.",
+						},
+						"retry_count": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Number of times a failed execution is retried. Defaults to `0`.",
+							Validators:  []validator.Int64{int64validator.AtLeast(0)},
+						},
+						"device_ids": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Devices the test runs on, for browser and mobile tests.",
+						},
+					},
+				},
+			},
+			"locations": schema.ListNestedBlock{
+				Description: "Usage of each location, sorted by location.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"location": schema.StringAttribute{
+							Computed:    true,
+							Description: "The location, for example `aws:eu-central-1` or the ID of a private location.",
+						},
+						"test_count": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of scheduled tests running from the location.",
+						},
+						"runs_per_hour": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Expected number of executions per hour from the location, when no execution is retried.",
+						},
+						"max_runs_per_hour": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Number of executions per hour from the location when every execution is retried as many times as allowed.",
+						},
+						"concurrent_executions_upper_bound": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Upper bound of the number of executions running at the same time from the location: the executions of all its tests and their devices, if their schedules coincided.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *datadogSyntheticsUsagePlanDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var state datadogSyntheticsUsagePlanDataSourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	includePaused := state.IncludePaused.ValueBool()
+	tests := make([]syntheticsUsagePlanTest, 0)
+	addTest := func(test datadogV1.SyntheticsTestDetails) {
+		if test.GetStatus() == datadogV1.SYNTHETICSTESTPAUSESTATUS_PAUSED && !includePaused {
+			return
+		}
+		options := test.GetOptions()
+		retry := options.GetRetry()
+		tests = append(tests, syntheticsUsagePlanTest{
+			locations: test.GetLocations(),
+			tickEvery: options.GetTickEvery(),
+			retries:   retry.GetCount(),
+			devices:   int64(len(options.GetDeviceIds())),
+		})
+	}
+
+	if len(state.TestIDs) > 0 {
+		for _, id := range state.TestIDs {
+			test, httpResp, err := d.Api.GetTest(d.Auth, id.ValueString())
+			if err != nil {
+				response.Diagnostics.Append(utils.FrameworkErrorDiag(utils.TranslateClientError(err, httpResp, ""), fmt.Sprintf("error getting synthetics test %s", id.ValueString())))
+				return
+			}
+			addTest(test)
+		}
+	} else if len(state.PlannedTests) == 0 {
+		result, cancel := d.Api.ListTestsWithPagination(d.Auth)
+		defer cancel()
+		for paginationResult := range result {
+			if paginationResult.Error != nil {
+				response.Diagnostics.Append(utils.FrameworkErrorDiag(paginationResult.Error, "Error when calling `ListTestsWithPagination`"))
+				return
+			}
+			addTest(paginationResult.Item)
+		}
+	}
+
+	for _, planned := range state.PlannedTests {
+		locations := make([]string, 0, len(planned.Locations))
+		for _, location := range planned.Locations {
+			locations = append(locations, location.ValueString())
+		}
+		tests = append(tests, syntheticsUsagePlanTest{
+			locations: locations,
+			tickEvery: planned.TickEvery.ValueInt64(),
+			retries:   planned.RetryCount.ValueInt64(),
+			devices:   int64(len(planned.DeviceIDs)),
+		})
+	}
+
+	d.updateState(&state, tests)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (d *datadogSyntheticsUsagePlanDataSource) updateState(state *datadogSyntheticsUsagePlanDataSourceModel, tests []syntheticsUsagePlanTest) {
+	usage := map[string]*syntheticsUsagePlanLocationModel{}
+	var testCount, concurrentExecutionsUpperBound int64
+	var runsPerHour, maxRunsPerHour float64
+	for _, test := range tests {
+		if test.tickEvery <= 0 {
+			continue
+		}
+		testCount++
+
+		// Tests without devices, like API tests, run once per location
+		executions := max(test.devices, 1)
+		testRunsPerHour := 3600 / float64(test.tickEvery) * float64(executions)
+		testMaxRunsPerHour := testRunsPerHour * float64(1+test.retries)
+		for _, location := range test.locations {
+			locationUsage, ok := usage[location]
+			if !ok {
+				locationUsage = &syntheticsUsagePlanLocationModel{
+					Location:                       types.StringValue(location),
+					TestCount:                      types.Int64Value(0),
+					RunsPerHour:                    types.Float64Value(0),
+					MaxRunsPerHour:                 types.Float64Value(0),
+					ConcurrentExecutionsUpperBound: types.Int64Value(0),
+				}
+				usage[location] = locationUsage
+			}
+			locationUsage.TestCount = types.Int64Value(locationUsage.TestCount.ValueInt64() + 1)
+			locationUsage.RunsPerHour = types.Float64Value(locationUsage.RunsPerHour.ValueFloat64() + testRunsPerHour)
+			locationUsage.MaxRunsPerHour = types.Float64Value(locationUsage.MaxRunsPerHour.ValueFloat64() + testMaxRunsPerHour)
+			locationUsage.ConcurrentExecutionsUpperBound = types.Int64Value(locationUsage.ConcurrentExecutionsUpperBound.ValueInt64() + executions)
+
+			runsPerHour += testRunsPerHour
+			maxRunsPerHour += testMaxRunsPerHour
+			concurrentExecutionsUpperBound += executions
+		}
+	}
+
+	locations := make([]*syntheticsUsagePlanLocationModel, 0, len(usage))
+	for _, locationUsage := range usage {
+		locations = append(locations, locationUsage)
+	}
+	sort.Slice(locations, func(i, j int) bool {
+		return locations[i].Location.ValueString() < locations[j].Location.ValueString()
+	})
+
+	testIDs := make([]string, 0, len(state.TestIDs))
+	for _, id := range state.TestIDs {
+		testIDs = append(testIDs, id.ValueString())
+	}
+	plannedTests := make([]string, 0, len(state.PlannedTests))
+	for _, planned := range state.PlannedTests {
+		locations := make([]string, 0, len(planned.Locations))
+		for _, location := range planned.Locations {
+			locations = append(locations, location.ValueString())
+		}
+		deviceIDs := make([]string, 0, len(planned.DeviceIDs))
+		for _, deviceID := range planned.DeviceIDs {
+			deviceIDs = append(deviceIDs, deviceID.ValueString())
+		}
+		plannedTests = append(plannedTests, fmt.Sprintf("%s/%d/%s/%d/%s",
+			planned.Name.ValueString(),
+			planned.TickEvery.ValueInt64(),
+			strings.Join(locations, ","),
+			planned.RetryCount.ValueInt64(),
+			strings.Join(deviceIDs, ","),
+		))
+	}
+	hashingData := fmt.Sprintf("%s:%t:%s", strings.Join(testIDs, ","), state.IncludePaused.ValueBool(), strings.Join(plannedTests, ";"))
+
+	state.ID = types.StringValue(utils.ConvertToSha256(hashingData))
+	state.TestCount = types.Int64Value(testCount)
+	state.RunsPerHour = types.Float64Value(runsPerHour)
+	state.MaxRunsPerHour = types.Float64Value(maxRunsPerHour)
+	state.ConcurrentExecutionsUpperBound = types.Int64Value(concurrentExecutionsUpperBound)
+	state.Locations = locations
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -29,6 +30,7 @@ import (
 )
 
 var _ provider.Provider = &FrameworkProvider{}
+var _ provider.ProviderWithFunctions = &FrameworkProvider{}
 
 var Resources = []func() resource.Resource{
 	NewAgentlessScanningAwsScanOptionsResource,
@@ -41,6 +43,8 @@ var Resources = []func() resource.Resource{
 	NewIntegrationAwsAccountResource,
 	NewCatalogEntityResource,
 	NewDashboardListResource,
+	NewDashboardListItemResource,
+	NewDashboardShareResource,
 	NewDatasetResource,
 	NewDomainAllowlistResource,
 	NewDowntimeScheduleResource,
@@ -68,6 +72,15 @@ var Resources = []func() resource.Resource{
 	NewSyntheticsConcurrencyCapResource,
 	NewSyntheticsGlobalVariableResource,
 	NewSyntheticsPrivateLocationResource,
+	NewSyntheticsMobileApplicationResource,
+	NewSyntheticsMobileApplicationVersionResource,
+	NewSloBurnRateAlertsResource,
+	NewServiceLevelObjectiveResource,
+	NewSloCorrectionResource,
+	NewSyntheticsAPITestResource,
+	NewSyntheticsMultistepAPITestResource,
+	NewSyntheticsBrowserTestResource,
+	NewSyntheticsMobileTestResource,
 	NewTeamLinkResource,
 	NewTeamMembershipResource,
 	NewTeamPermissionSettingResource,
@@ -119,6 +132,7 @@ var Datasources = []func() datasource.DataSource{
 	NewAwsLogsServicesDataSource,
 	NewDatadogApmRetentionFiltersOrderDataSource,
 	NewDatadogDashboardListDataSource,
+	NewDatadogDashboardsDataSource,
 	NewDatadogIntegrationAWSNamespaceRulesDatasource,
 	NewDatadogMetricActiveTagsAndAggregationsDataSource,
 	NewDatadogMetricMetadataDataSource,
@@ -142,6 +156,13 @@ var Datasources = []func() datasource.DataSource{
 	NewDatadogActionConnectionDataSource,
 	NewDatadogSyntheticsGlobalVariableDataSource,
 	NewDatadogSyntheticsLocationsDataSource,
+	NewDatadogSyntheticsTestsDataSource,
+	NewDatadogDowntimesDataSource,
+	NewDatadogOnCallScheduleOnCallUserDataSource,
+	NewDatadogOnCallRoutingSimulationDataSource,
+	NewDatadogSyntheticsPrivateLocationStatusDataSource,
+	NewDatadogSyntheticsUsagePlanDataSource,
+	NewDatadogServiceLevelObjectiveStatusDataSource,
 	NewWorkflowAutomationDataSource,
 	NewDatadogAppBuilderAppDataSource,
 	NewCostBudgetDataSource,
@@ -157,6 +178,10 @@ var Datasources = []func() datasource.DataSource{
 	NewDatadogAzureUcConfigDataSource,
 }
 
+var Functions = []func() function.Function{
+	NewSyntheticsBrowserStepsFunction,
+}
+
 // FrameworkProvider struct
 type FrameworkProvider struct {
 	CommunityClient     *datadogCommunity.Client
@@ -195,6 +220,7 @@ type DefaultTag struct {
 func New() provider.Provider {
 	return &FrameworkProvider{
 		ConfigureCallbackFunc: defaultConfigureFunc,
+		Now:                   time.Now,
 	}
 }
 
@@ -223,6 +249,10 @@ func (p *FrameworkProvider) DataSources(_ context.Context) []func() datasource.D
 	return wrappedDatasources
 }
 
+func (p *FrameworkProvider) Functions(_ context.Context) []func() function.Function {
+	return Functions
+}
+
 func (p *FrameworkProvider) Metadata(_ context.Context, _ provider.MetadataRequest, response *provider.MetadataResponse) {
 	response.TypeName = "datadog_"
 }
@@ -741,6 +771,7 @@ var (
 	_ resource.ResourceWithModifyPlan       = &FrameworkResourceWrapper{}
 	_ resource.ResourceWithUpgradeState     = &FrameworkResourceWrapper{}
 	_ resource.ResourceWithValidateConfig   = &FrameworkResourceWrapper{}
+	_ resource.ResourceWithMoveState        = &FrameworkResourceWrapper{}
 )
 
 func NewFrameworkResourceWrapper(i *resource.Resource) resource.Resource {
@@ -836,6 +867,13 @@ func (r *FrameworkResourceWrapper) UpgradeState(ctx context.Context) map[int64]r
 	return nil
 }
 
+func (r *FrameworkResourceWrapper) MoveState(ctx context.Context) []resource.StateMover {
+	if v, ok := (*r.innerResource).(resource.ResourceWithMoveState); ok {
+		return v.MoveState(ctx)
+	}
+	return nil
+}
+
 func (r *FrameworkResourceWrapper) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	if v, ok := (*r.innerResource).(resource.ResourceWithValidateConfig); ok {
 		v.ValidateConfig(ctx, req, resp)
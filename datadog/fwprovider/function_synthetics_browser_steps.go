@@ -0,0 +1,90 @@
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/fwutils"
+)
+
+var (
+	_ function.Function = &syntheticsBrowserStepsFunction{}
+)
+
+type syntheticsBrowserStepsFunction struct{}
+
+func NewSyntheticsBrowserStepsFunction() function.Function {
+	return &syntheticsBrowserStepsFunction{}
+}
+
+func (f *syntheticsBrowserStepsFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "synthetics_browser_steps"
+}
+
+func (f *syntheticsBrowserStepsFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary: "Convert a browser test recording to browser steps",
+		MarkdownDescription: "Converts the steps of a browser test recording, as exported by Datadog, to objects matching the `browser_step` blocks of the `datadog_synthetics_test` and `datadog_synthetics_browser_test` resources, to be used in a `dynamic` block. " +
+			"Blocks with at most one element, such as `params` or `params.variable`, are converted to objects, which are null when not set. Recordings with step types or params not supported by the provider are rejected.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "recording",
+				Description: "The JSON recording, either the exported browser test or the list of its steps.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: syntheticsBrowserStepObjectType(),
+		},
+	}
+}
+
+func (f *syntheticsBrowserStepsFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var recording string
+	response.Error = request.Arguments.Get(ctx, &recording)
+	if response.Error != nil {
+		return
+	}
+
+	steps, err := datadog.SyntheticsBrowserStepsFromRecording([]byte(recording))
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	stepSchema := syntheticsBrowserStepSchema()
+	values := make([]attr.Value, 0, len(steps))
+	for _, step := range steps {
+		value, err := fwutils.SDKObjectValue(stepSchema, step)
+		if err != nil {
+			response.Error = function.NewFuncError(err.Error())
+			return
+		}
+		values = append(values, value)
+	}
+	result, diags := types.ListValue(syntheticsBrowserStepObjectType(), values)
+	response.Error = function.FuncErrorFromDiags(ctx, diags)
+	if response.Error != nil {
+		return
+	}
+	response.Error = response.Result.Set(ctx, result)
+}
+
+// syntheticsBrowserStepSchema returns the attributes of the `browser_step` block that can be set by users.
+func syntheticsBrowserStepSchema() map[string]*sdkschema.Schema {
+	stepSchema := map[string]*sdkschema.Schema{}
+	for key, s := range datadog.SyntheticsTestResource().SchemaMap()["browser_step"].Elem.(*sdkschema.Resource).Schema {
+		if !s.Computed {
+			stepSchema[key] = s
+		}
+	}
+	return stepSchema
+}
+
+func syntheticsBrowserStepObjectType() types.ObjectType {
+	return fwutils.SDKObjectType(syntheticsBrowserStepSchema())
+}
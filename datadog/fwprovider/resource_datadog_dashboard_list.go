@@ -6,9 +6,11 @@ import (
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	frameworkPath "github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -32,9 +34,10 @@ type dashboardListResource struct {
 }
 
 type dashboardListResourceModel struct {
-	ID       types.String     `tfsdk:"id"`
-	Name     types.String     `tfsdk:"name"`
-	DashItem []*dashItemModel `tfsdk:"dash_item"`
+	ID                  types.String     `tfsdk:"id"`
+	Name                types.String     `tfsdk:"name"`
+	IgnoreExternalItems types.Bool       `tfsdk:"ignore_external_items"`
+	DashItem            []*dashItemModel `tfsdk:"dash_item"`
 }
 
 type dashItemModel struct {
@@ -65,6 +68,12 @@ func (r *dashboardListResource) Schema(ctx context.Context, req resource.SchemaR
 				Description: "The name of the Dashboard List",
 				Required:    true,
 			},
+			"ignore_external_items": schema.BoolAttribute{
+				Description: "Whether to only manage the dashboards listed in `dash_item`, leaving in place the dashboards added to the list by other means, such as the `datadog_dashboard_list_item` resource. When `false`, dashboards not listed in `dash_item` are removed from the list.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
 			"id": utils.ResourceIDAttribute(),
 		},
 		Blocks: map[string]schema.Block{
@@ -165,6 +174,21 @@ func (r *dashboardListResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	if state.IgnoreExternalItems.ValueBool() {
+		var priorState dashboardListResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(r.updateManagedItems(id, &priorState, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// Save data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
 	// Delete all elements from the dash list and add back only the ones in the config
 	completeDashListV2, httpresp, err := r.ApiV2.GetDashboardListItems(r.Auth, id)
 	if err != nil {
@@ -228,6 +252,9 @@ func (r *dashboardListResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 	state.Name = types.StringValue(dashList.GetName())
+	if state.IgnoreExternalItems.IsNull() {
+		state.IgnoreExternalItems = types.BoolValue(false)
+	}
 
 	// Read and set all the dashboard list elements
 	completeItemListV2, _, err := r.ApiV2.GetDashboardListItems(r.Auth, id)
@@ -239,7 +266,11 @@ func (r *dashboardListResource) Read(ctx context.Context, req resource.ReadReque
 		resp.Diagnostics.AddError("", err.Error())
 		return
 	}
-	r.updateStateFromDashItem(ctx, &state, completeItemListV2.GetDashboards())
+	dashboards := completeItemListV2.GetDashboards()
+	if state.IgnoreExternalItems.ValueBool() {
+		dashboards = filterManagedDashItems(&state, dashboards)
+	}
+	r.updateStateFromDashItem(ctx, &state, dashboards)
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -329,3 +360,63 @@ func (r *dashboardListResource) updateStateFromDashItem(ctx context.Context, sta
 	}
 	state.DashItem = dashItemList
 }
+
+// updateManagedItems adds the dashboards of `dash_item` missing from the prior state to the list, and removes
+// the ones no longer in `dash_item`, without touching the other dashboards of the list.
+func (r *dashboardListResource) updateManagedItems(id int64, priorState *dashboardListResourceModel, state *dashboardListResourceModel) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	toRemove := make([]datadogV2.DashboardListItemRequest, 0)
+	for _, prior := range priorState.DashItem {
+		if !containsDashItem(state.DashItem, prior.DashId.ValueString()) {
+			toRemove = append(toRemove, *datadogV2.NewDashboardListItemRequest(prior.DashId.ValueString(), datadogV2.DashboardType(prior.Type.ValueString())))
+		}
+	}
+	if len(toRemove) > 0 {
+		body := datadogV2.NewDashboardListDeleteItemsRequest()
+		body.SetDashboards(toRemove)
+		_, httpresp, err := r.ApiV2.DeleteDashboardListItems(r.Auth, id, *body)
+		if err != nil {
+			diags.Append(utils.FrameworkErrorDiag(utils.TranslateClientError(err, httpresp, ""), "error deleting dashboard list item"))
+			return diags
+		}
+	}
+
+	toAdd := make([]datadogV2.DashboardListItemRequest, 0)
+	for _, item := range state.DashItem {
+		if !containsDashItem(priorState.DashItem, item.DashId.ValueString()) {
+			toAdd = append(toAdd, *datadogV2.NewDashboardListItemRequest(item.DashId.ValueString(), datadogV2.DashboardType(item.Type.ValueString())))
+		}
+	}
+	if len(toAdd) > 0 {
+		body := datadogV2.NewDashboardListAddItemsRequest()
+		body.SetDashboards(toAdd)
+		_, httpresp, err := r.ApiV2.CreateDashboardListItems(r.Auth, id, *body)
+		if err != nil {
+			diags.Append(utils.FrameworkErrorDiag(utils.TranslateClientError(err, httpresp, ""), "error adding dashboard list item"))
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// filterManagedDashItems returns the dashboards of the list that are part of `dash_item`.
+func filterManagedDashItems(state *dashboardListResourceModel, dashboards []datadogV2.DashboardListItem) []datadogV2.DashboardListItem {
+	managed := make([]datadogV2.DashboardListItem, 0)
+	for _, dashboard := range dashboards {
+		if containsDashItem(state.DashItem, dashboard.GetId()) {
+			managed = append(managed, dashboard)
+		}
+	}
+	return managed
+}
+
+func containsDashItem(dashItems []*dashItemModel, dashId string) bool {
+	for _, dashItem := range dashItems {
+		if dashItem.DashId.ValueString() == dashId {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,210 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = &dashboardListItemResource{}
+	_ resource.ResourceWithImportState = &dashboardListItemResource{}
+)
+
+func NewDashboardListItemResource() resource.Resource {
+	return &dashboardListItemResource{}
+}
+
+type dashboardListItemResource struct {
+	Api  *datadogV2.DashboardListsApi
+	Auth context.Context
+}
+
+type dashboardListItemResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	DashboardListId types.String `tfsdk:"dashboard_list_id"`
+	DashId          types.String `tfsdk:"dash_id"`
+	Type            types.String `tfsdk:"type"`
+}
+
+func (r *dashboardListItemResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerData := req.ProviderData.(*FrameworkProvider)
+	r.Api = providerData.DatadogApiInstances.GetDashboardListsApiV2()
+	r.Auth = providerData.Auth
+}
+
+func (r *dashboardListItemResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "dashboard_list_item"
+}
+
+func (r *dashboardListItemResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides a Datadog dashboard_list_item resource. This can be used to add a single dashboard to a Dashboard List, independently of the other dashboards of the list. When the list is also managed with the `datadog_dashboard_list` resource, set its `ignore_external_items` to `true`.",
+		Attributes: map[string]schema.Attribute{
+			"dashboard_list_id": schema.StringAttribute{
+				Description: "The ID of the Dashboard List to add the dashboard to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dash_id": schema.StringAttribute{
+				Description: "The ID of the dashboard to add.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the dashboard.",
+				Required:    true,
+				Validators: []validator.String{
+					validators.NewEnumValidator[validator.String](datadogV2.NewDashboardTypeFromValue),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": utils.ResourceIDAttribute(),
+		},
+	}
+}
+
+func (r *dashboardListItemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	result := strings.SplitN(req.ID, ":", 2)
+	if len(result) != 2 {
+		resp.Diagnostics.AddError("error retrieving dashboard_list_id or dash_id from given ID", `Expected format: "dashboard_list_id:dash_id"`)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dashboard_list_id"), result[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dash_id"), result[1])...)
+}
+
+func (r *dashboardListItemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state dashboardListItemResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listId, err := strconv.ParseInt(state.DashboardListId.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to parse dashboard_list_id: ", err.Error())
+		return
+	}
+
+	body := datadogV2.NewDashboardListAddItemsRequest()
+	body.SetDashboards([]datadogV2.DashboardListItemRequest{
+		*datadogV2.NewDashboardListItemRequest(state.DashId.ValueString(), datadogV2.DashboardType(state.Type.ValueString())),
+	})
+	addResp, httpresp, err := r.Api.CreateDashboardListItems(r.Auth, listId, *body)
+	if err != nil {
+		resp.Diagnostics.Append(utils.FrameworkErrorDiag(utils.TranslateClientError(err, httpresp, ""), "error adding dashboard list item"))
+		return
+	}
+	if err := utils.CheckForUnparsed(addResp); err != nil {
+		resp.Diagnostics.Append(utils.FrameworkErrorDiag(err, ""))
+		return
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("%d:%s", listId, state.DashId.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *dashboardListItemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dashboardListItemResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listId, err := strconv.ParseInt(state.DashboardListId.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to parse dashboard_list_id: ", err.Error())
+		return
+	}
+
+	items, httpresp, err := r.Api.GetDashboardListItems(r.Auth, listId)
+	if err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.FrameworkErrorDiag(utils.TranslateClientError(err, httpresp, ""), "error getting dashboard list items"))
+		return
+	}
+	if err := utils.CheckForUnparsed(items); err != nil {
+		resp.Diagnostics.Append(utils.FrameworkErrorDiag(err, ""))
+		return
+	}
+
+	var found *datadogV2.DashboardListItem
+	for _, dashboard := range items.GetDashboards() {
+		if dashboard.GetId() == state.DashId.ValueString() {
+			found = &dashboard
+			break
+		}
+	}
+	if found == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.Type = types.StringValue(string(found.GetType()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *dashboardListItemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All attributes require replacement, there is nothing to update in place
+	var state dashboardListItemResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *dashboardListItemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dashboardListItemResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listId, err := strconv.ParseInt(state.DashboardListId.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to parse dashboard_list_id: ", err.Error())
+		return
+	}
+
+	body := datadogV2.NewDashboardListDeleteItemsRequest()
+	body.SetDashboards([]datadogV2.DashboardListItemRequest{
+		*datadogV2.NewDashboardListItemRequest(state.DashId.ValueString(), datadogV2.DashboardType(state.Type.ValueString())),
+	})
+	_, httpresp, err := r.Api.DeleteDashboardListItems(r.Auth, listId, *body)
+	if err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.Append(utils.FrameworkErrorDiag(utils.TranslateClientError(err, httpresp, ""), "error deleting dashboard list item"))
+		return
+	}
+}
@@ -0,0 +1,464 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	frameworkPath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
+)
+
+var (
+	_ resource.ResourceWithConfigure      = &dashboardShareResource{}
+	_ resource.ResourceWithImportState    = &dashboardShareResource{}
+	_ resource.ResourceWithValidateConfig = &dashboardShareResource{}
+)
+
+type dashboardShareResource struct {
+	Api  *datadogV1.DashboardsApi
+	Auth context.Context
+}
+
+type dashboardShareModel struct {
+	ID                          types.String                  `tfsdk:"id"`
+	DashboardId                 types.String                  `tfsdk:"dashboard_id"`
+	DashboardType               types.String                  `tfsdk:"dashboard_type"`
+	ShareType                   types.String                  `tfsdk:"share_type"`
+	Title                       types.String                  `tfsdk:"title"`
+	Status                      types.String                  `tfsdk:"status"`
+	Invitees                    types.Set                     `tfsdk:"invitees"`
+	EmbeddableDomains           types.Set                     `tfsdk:"embeddable_domains"`
+	GlobalTimeLiveSpan          types.String                  `tfsdk:"global_time_live_span"`
+	GlobalTimeSelectableEnabled types.Bool                    `tfsdk:"global_time_selectable_enabled"`
+	PublicUrl                   types.String                  `tfsdk:"public_url"`
+	SelectableTemplateVar       []*selectableTemplateVarModel `tfsdk:"selectable_template_var"`
+}
+
+type selectableTemplateVarModel struct {
+	Name         types.String `tfsdk:"name"`
+	Prefix       types.String `tfsdk:"prefix"`
+	DefaultValue types.String `tfsdk:"default_value"`
+	VisibleTags  types.List   `tfsdk:"visible_tags"`
+}
+
+func NewDashboardShareResource() resource.Resource {
+	return &dashboardShareResource{}
+}
+
+func (r *dashboardShareResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	providerData := request.ProviderData.(*FrameworkProvider)
+	r.Api = providerData.DatadogApiInstances.GetDashboardsApiV1()
+	r.Auth = providerData.Auth
+}
+
+func (r *dashboardShareResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "dashboard_share"
+}
+
+func (r *dashboardShareResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Provides a Datadog shared dashboard resource. This can be used to share a dashboard publicly, with a list of invited viewers, or as an embed on allowed domains.",
+		Attributes: map[string]schema.Attribute{
+			"dashboard_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the dashboard to share.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dashboard_type": schema.StringAttribute{
+				Required:    true,
+				Description: "The type of the shared dashboard.",
+				Validators: []validator.String{
+					validators.NewEnumValidator[validator.String](datadogV1.NewDashboardTypeFromValue),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"share_type": schema.StringAttribute{
+				Required:    true,
+				Description: "The type of sharing. `open` dashboards are publicly accessible, `invite` dashboards are only accessible to the `invitees`, and `embed` dashboards can only be embedded on the `embeddable_domains`.",
+				Validators: []validator.String{
+					validators.NewEnumValidator[validator.String](datadogV1.NewDashboardShareTypeFromValue),
+				},
+			},
+			"title": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Title of the shared dashboard. Defaults to the title of the dashboard.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the shared dashboard is `active` or `paused`. Paused shared dashboards can't be accessed.",
+				Validators: []validator.String{
+					validators.NewEnumValidator[validator.String](datadogV1.NewSharedDashboardStatusFromValue),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"invitees": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Email addresses of the users allowed to view the shared dashboard. Only valid when `share_type` is `invite`.",
+			},
+			"embeddable_domains": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Domains on which the shared dashboard can be embedded, for example `https://example.com`. Only valid when `share_type` is `embed`.",
+			},
+			"global_time_live_span": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The default timeframe of the shared dashboard.",
+				Validators: []validator.String{
+					validators.NewEnumValidator[validator.String](datadogV1.NewDashboardGlobalTimeLiveSpanFromValue),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"global_time_selectable_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether viewers can change the timeframe of the shared dashboard.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"public_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL of the shared dashboard.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": utils.ResourceIDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"selectable_template_var": schema.ListNestedBlock{
+				Description: "Template variables that viewers of the shared dashboard can change.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "The name of the template variable.",
+						},
+						"prefix": schema.StringAttribute{
+							Optional:    true,
+							Description: "The tag prefix associated with the template variable.",
+						},
+						"default_value": schema.StringAttribute{
+							Optional:    true,
+							Description: "The default value of the template variable.",
+						},
+						"visible_tags": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "The tag values viewers can select for the template variable.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *dashboardShareResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config dashboardShareModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() || config.ShareType.IsUnknown() || config.ShareType.IsNull() {
+		return
+	}
+
+	shareType := datadogV1.DashboardShareType(config.ShareType.ValueString())
+	if !config.Invitees.IsNull() && shareType != datadogV1.DASHBOARDSHARETYPE_INVITE {
+		response.Diagnostics.AddAttributeError(frameworkPath.Root("invitees"), "invalid attribute combination", fmt.Sprintf("`invitees` can only be set when `share_type` is `%s`", datadogV1.DASHBOARDSHARETYPE_INVITE))
+	}
+	if !config.EmbeddableDomains.IsNull() && shareType != datadogV1.DASHBOARDSHARETYPE_EMBED {
+		response.Diagnostics.AddAttributeError(frameworkPath.Root("embeddable_domains"), "invalid attribute combination", fmt.Sprintf("`embeddable_domains` can only be set when `share_type` is `%s`", datadogV1.DASHBOARDSHARETYPE_EMBED))
+	}
+}
+
+func (r *dashboardShareResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, frameworkPath.Root("id"), request, response)
+}
+
+func (r *dashboardShareResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state dashboardShareModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, httpResp, err := r.Api.GetPublicDashboard(r.Auth, state.ID.ValueString())
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.Append(utils.FrameworkErrorDiag(utils.TranslateClientError(err, httpResp, ""), "error retrieving shared dashboard"))
+		return
+	}
+	if err := utils.CheckForUnparsed(resp); err != nil {
+		response.Diagnostics.AddError("response contains unparsedObject", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(r.updateState(ctx, &state, &resp)...)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *dashboardShareResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var state dashboardShareModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := r.buildDashboardShareRequestBody(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, httpResp, err := r.Api.CreatePublicDashboard(r.Auth, *body)
+	if err != nil {
+		response.Diagnostics.Append(utils.FrameworkErrorDiag(utils.TranslateClientError(err, httpResp, ""), "error creating shared dashboard"))
+		return
+	}
+	if err := utils.CheckForUnparsed(resp); err != nil {
+		response.Diagnostics.AddError("response contains unparsedObject", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(r.updateState(ctx, &state, &resp)...)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *dashboardShareResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var state dashboardShareModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := r.buildDashboardShareUpdateRequestBody(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, httpResp, err := r.Api.UpdatePublicDashboard(r.Auth, state.ID.ValueString(), *body)
+	if err != nil {
+		response.Diagnostics.Append(utils.FrameworkErrorDiag(utils.TranslateClientError(err, httpResp, ""), "error updating shared dashboard"))
+		return
+	}
+	if err := utils.CheckForUnparsed(resp); err != nil {
+		response.Diagnostics.AddError("response contains unparsedObject", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(r.updateState(ctx, &state, &resp)...)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *dashboardShareResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state dashboardShareModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, httpResp, err := r.Api.DeletePublicDashboard(r.Auth, state.ID.ValueString())
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		response.Diagnostics.Append(utils.FrameworkErrorDiag(utils.TranslateClientError(err, httpResp, ""), "error deleting shared dashboard"))
+		return
+	}
+}
+
+func (r *dashboardShareResource) updateState(ctx context.Context, state *dashboardShareModel, resp *datadogV1.SharedDashboard) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	state.ID = types.StringValue(resp.GetToken())
+	state.DashboardId = types.StringValue(resp.GetDashboardId())
+	state.DashboardType = types.StringValue(string(resp.GetDashboardType()))
+	state.ShareType = types.StringValue(string(resp.GetShareType()))
+	state.Title = types.StringValue(resp.GetTitle())
+	if status, ok := resp.GetStatusOk(); ok {
+		state.Status = types.StringValue(string(*status))
+	} else {
+		state.Status = types.StringNull()
+	}
+	state.PublicUrl = types.StringValue(resp.GetPublicUrl())
+	state.GlobalTimeSelectableEnabled = types.BoolValue(resp.GetGlobalTimeSelectableEnabled())
+
+	if globalTime, ok := resp.GetGlobalTimeOk(); ok {
+		state.GlobalTimeLiveSpan = types.StringValue(string(globalTime.GetLiveSpan()))
+	} else {
+		state.GlobalTimeLiveSpan = types.StringNull()
+	}
+
+	if invitees, ok := resp.GetInviteesOk(); ok && len(*invitees) > 0 {
+		emails := make([]string, 0, len(*invitees))
+		for _, invitee := range *invitees {
+			emails = append(emails, invitee.GetEmail())
+		}
+		var d diag.Diagnostics
+		state.Invitees, d = types.SetValueFrom(ctx, types.StringType, emails)
+		diags.Append(d...)
+	} else if !state.Invitees.IsNull() {
+		state.Invitees = types.SetValueMust(types.StringType, []attr.Value{})
+	}
+
+	if domains, ok := resp.GetEmbeddableDomainsOk(); ok && len(*domains) > 0 {
+		var d diag.Diagnostics
+		state.EmbeddableDomains, d = types.SetValueFrom(ctx, types.StringType, *domains)
+		diags.Append(d...)
+	} else if !state.EmbeddableDomains.IsNull() {
+		state.EmbeddableDomains = types.SetValueMust(types.StringType, []attr.Value{})
+	}
+
+	var templateVars []*selectableTemplateVarModel
+	for _, templateVar := range resp.GetSelectableTemplateVars() {
+		templateVarModel := &selectableTemplateVarModel{
+			Name:         types.StringValue(templateVar.GetName()),
+			Prefix:       types.StringPointerValue(templateVar.Prefix),
+			DefaultValue: types.StringPointerValue(templateVar.DefaultValue),
+			VisibleTags:  types.ListNull(types.StringType),
+		}
+		if visibleTags, ok := templateVar.GetVisibleTagsOk(); ok && visibleTags != nil {
+			var d diag.Diagnostics
+			templateVarModel.VisibleTags, d = types.ListValueFrom(ctx, types.StringType, *visibleTags)
+			diags.Append(d...)
+		}
+		templateVars = append(templateVars, templateVarModel)
+	}
+	state.SelectableTemplateVar = templateVars
+
+	return diags
+}
+
+func (r *dashboardShareResource) buildDashboardShareRequestBody(ctx context.Context, state *dashboardShareModel) (*datadogV1.SharedDashboard, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	req := datadogV1.NewSharedDashboard(state.DashboardId.ValueString(), datadogV1.DashboardType(state.DashboardType.ValueString()))
+	req.SetShareType(datadogV1.DashboardShareType(state.ShareType.ValueString()))
+	if !state.Title.IsNull() && !state.Title.IsUnknown() {
+		req.SetTitle(state.Title.ValueString())
+	}
+	if !state.Status.IsNull() && !state.Status.IsUnknown() {
+		req.SetStatus(datadogV1.SharedDashboardStatus(state.Status.ValueString()))
+	}
+	if !state.GlobalTimeLiveSpan.IsNull() && !state.GlobalTimeLiveSpan.IsUnknown() {
+		globalTime := datadogV1.NewDashboardGlobalTime()
+		globalTime.SetLiveSpan(datadogV1.DashboardGlobalTimeLiveSpan(state.GlobalTimeLiveSpan.ValueString()))
+		req.SetGlobalTime(*globalTime)
+	}
+	if !state.GlobalTimeSelectableEnabled.IsNull() && !state.GlobalTimeSelectableEnabled.IsUnknown() {
+		req.SetGlobalTimeSelectableEnabled(state.GlobalTimeSelectableEnabled.ValueBool())
+	}
+
+	invitees, d := buildSharedDashboardInvitees(ctx, state)
+	diags.Append(d...)
+	req.SetInvitees(invitees)
+
+	var domains []string
+	diags.Append(state.EmbeddableDomains.ElementsAs(ctx, &domains, false)...)
+	req.SetEmbeddableDomains(domains)
+
+	templateVars, d := buildSelectableTemplateVars(ctx, state)
+	diags.Append(d...)
+	req.SetSelectableTemplateVars(templateVars)
+
+	return req, diags
+}
+
+func (r *dashboardShareResource) buildDashboardShareUpdateRequestBody(ctx context.Context, state *dashboardShareModel) (*datadogV1.SharedDashboardUpdateRequest, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	req := datadogV1.NewSharedDashboardUpdateRequestWithDefaults()
+	req.SetShareType(datadogV1.DashboardShareType(state.ShareType.ValueString()))
+	if !state.Title.IsNull() && !state.Title.IsUnknown() {
+		req.SetTitle(state.Title.ValueString())
+	}
+	if !state.Status.IsNull() && !state.Status.IsUnknown() {
+		req.SetStatus(datadogV1.SharedDashboardStatus(state.Status.ValueString()))
+	}
+	if !state.GlobalTimeLiveSpan.IsNull() && !state.GlobalTimeLiveSpan.IsUnknown() {
+		globalTime := datadogV1.NewSharedDashboardUpdateRequestGlobalTime()
+		globalTime.SetLiveSpan(datadogV1.DashboardGlobalTimeLiveSpan(state.GlobalTimeLiveSpan.ValueString()))
+		req.SetGlobalTime(*globalTime)
+	}
+	if !state.GlobalTimeSelectableEnabled.IsNull() && !state.GlobalTimeSelectableEnabled.IsUnknown() {
+		req.SetGlobalTimeSelectableEnabled(state.GlobalTimeSelectableEnabled.ValueBool())
+	}
+
+	invitees, d := buildSharedDashboardInvitees(ctx, state)
+	diags.Append(d...)
+	req.SetInvitees(invitees)
+
+	var domains []string
+	diags.Append(state.EmbeddableDomains.ElementsAs(ctx, &domains, false)...)
+	req.SetEmbeddableDomains(domains)
+
+	templateVars, d := buildSelectableTemplateVars(ctx, state)
+	diags.Append(d...)
+	req.SetSelectableTemplateVars(templateVars)
+
+	return req, diags
+}
+
+func buildSharedDashboardInvitees(ctx context.Context, state *dashboardShareModel) ([]datadogV1.SharedDashboardInviteesItems, diag.Diagnostics) {
+	var emails []string
+	diags := state.Invitees.ElementsAs(ctx, &emails, false)
+
+	invitees := make([]datadogV1.SharedDashboardInviteesItems, 0, len(emails))
+	for _, email := range emails {
+		invitees = append(invitees, *datadogV1.NewSharedDashboardInviteesItems(email))
+	}
+	return invitees, diags
+}
+
+func buildSelectableTemplateVars(ctx context.Context, state *dashboardShareModel) ([]datadogV1.SelectableTemplateVariableItems, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	templateVars := make([]datadogV1.SelectableTemplateVariableItems, 0, len(state.SelectableTemplateVar))
+	for _, templateVarModel := range state.SelectableTemplateVar {
+		templateVar := datadogV1.NewSelectableTemplateVariableItems()
+		templateVar.SetName(templateVarModel.Name.ValueString())
+		if !templateVarModel.Prefix.IsNull() {
+			templateVar.SetPrefix(templateVarModel.Prefix.ValueString())
+		}
+		if !templateVarModel.DefaultValue.IsNull() {
+			templateVar.SetDefaultValue(templateVarModel.DefaultValue.ValueString())
+		}
+		if !templateVarModel.VisibleTags.IsNull() {
+			var visibleTags []string
+			diags.Append(templateVarModel.VisibleTags.ElementsAs(ctx, &visibleTags, false)...)
+			templateVar.SetVisibleTags(visibleTags)
+		}
+		templateVars = append(templateVars, *templateVar)
+	}
+	return templateVars, diags
+}
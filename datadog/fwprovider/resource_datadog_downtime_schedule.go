@@ -2,9 +2,17 @@ package fwprovider
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/fwutils"
 	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/planmodifiers"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/rrule"
 	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
 	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
 
@@ -24,11 +32,14 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = &DowntimeScheduleResource{}
 	_ resource.ResourceWithImportState = &DowntimeScheduleResource{}
+	_ resource.ResourceWithModifyPlan  = &DowntimeScheduleResource{}
+	_ resource.ResourceWithMoveState   = &DowntimeScheduleResource{}
 )
 
 type DowntimeScheduleResource struct {
 	Api  *datadogV2.DowntimesApi
 	Auth context.Context
+	Now  func() time.Time
 }
 
 type DowntimeScheduleModel struct {
@@ -42,6 +53,7 @@ type DowntimeScheduleModel struct {
 	MonitorIdentifier                  *MonitorIdentifierModel             `tfsdk:"monitor_identifier"`
 	DowntimeScheduleRecurrenceSchedule *DowntimeScheduleRecurrenceSchedule `tfsdk:"recurring_schedule"`
 	DowntimeScheduleOneTimeSchedule    *DowntimeScheduleOneTimeSchedule    `tfsdk:"one_time_schedule"`
+	NextOccurrences                    types.List                          `tfsdk:"next_occurrences"`
 }
 
 type MonitorIdentifierModel struct {
@@ -64,6 +76,9 @@ type DowntimeScheduleOneTimeSchedule struct {
 	Start types.String `tfsdk:"start"`
 }
 
+// Number of windows previewed in `next_occurrences`.
+const downtimeScheduleNextOccurrencesCount = 5
+
 func NewDowntimeScheduleResource() resource.Resource {
 	return &DowntimeScheduleResource{}
 }
@@ -72,6 +87,7 @@ func (r *DowntimeScheduleResource) Configure(_ context.Context, request resource
 	providerData, _ := request.ProviderData.(*FrameworkProvider)
 	r.Api = providerData.DatadogApiInstances.GetDowntimesApiV2()
 	r.Auth = providerData.Auth
+	r.Now = providerData.Now
 }
 
 func (r *DowntimeScheduleResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -80,7 +96,7 @@ func (r *DowntimeScheduleResource) Metadata(_ context.Context, request resource.
 
 func (r *DowntimeScheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
-		Description: "Provides a Datadog DowntimeSchedule resource. This can be used to create and manage Datadog downtimes.",
+		Description: "Provides a Datadog DowntimeSchedule resource. This can be used to create and manage Datadog downtimes. Existing `datadog_downtime` resources can be migrated with a `moved` block (Terraform 1.8 or later).",
 		Attributes: map[string]schema.Attribute{
 			"display_timezone": schema.StringAttribute{
 				Optional:    true,
@@ -113,6 +129,11 @@ func (r *DowntimeScheduleResource) Schema(_ context.Context, _ resource.SchemaRe
 				ElementType: types.StringType,
 			},
 			"id": utils.ResourceIDAttribute(),
+			"next_occurrences": schema.ListAttribute{
+				Computed:    true,
+				ElementType: fwutils.OccurrenceWindowType,
+				Description: fmt.Sprintf("The next %d windows during which the downtime applies, computed from the `one_time_schedule` or the `recurring_schedule` recurrences in the schedule's `timezone`. Each window has a `start` and an `end` in RFC 3339 format, `end` is null when the downtime never ends.", downtimeScheduleNextOccurrencesCount),
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"monitor_identifier": schema.SingleNestedBlock{
@@ -167,7 +188,8 @@ func (r *DowntimeScheduleResource) Schema(_ context.Context, _ resource.SchemaRe
 								},
 								"rrule": schema.StringAttribute{
 									Required:    true,
-									Description: "The `RRULE` standard for defining recurring events. For example, to have a recurring event on the first day of each month, set the type to `rrule` and set the `FREQ` to `MONTHLY` and `BYMONTHDAY` to `1`. Most common `rrule` options from the [iCalendar Spec](https://tools.ietf.org/html/rfc5545) are supported.  **Note**: Attributes specifying the duration in `RRULE` are not supported (for example, `DTSTART`, `DTEND`, `DURATION`). More examples available in this [downtime guide](https://docs.datadoghq.com/monitors/guide/suppress-alert-with-downtimes/?tab=api).",
+									Description: "The `RRULE` standard for defining recurring events. For example, to have a recurring event on the first day of each month, set the type to `rrule` and set the `FREQ` to `MONTHLY` and `BYMONTHDAY` to `1`. Most common `rrule` options from the [iCalendar Spec](https://tools.ietf.org/html/rfc5545) are supported.  **Note**: Attributes specifying the duration in `RRULE` are not supported (for example, `DTSTART`, `DTEND`, `DURATION`). More examples available in this [downtime guide](https://docs.datadoghq.com/monitors/guide/suppress-alert-with-downtimes/?tab=api). " + rrule.Downtimes.Description(),
+									Validators:  []validator.String{validators.RRuleValidator(rrule.Downtimes)},
 								},
 								"start": schema.StringAttribute{
 									Optional:    true,
@@ -190,6 +212,41 @@ func (r *DowntimeScheduleResource) ImportState(ctx context.Context, request reso
 	resource.ImportStatePassthroughID(ctx, frameworkPath.Root("id"), request, response)
 }
 
+func (r *DowntimeScheduleResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan DowntimeScheduleModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The preview only changes with the schedule, so that refreshing it doesn't plan an update
+	if !request.State.Raw.IsNull() {
+		var state DowntimeScheduleModel
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		if reflect.DeepEqual(plan.DowntimeScheduleRecurrenceSchedule, state.DowntimeScheduleRecurrenceSchedule) &&
+			reflect.DeepEqual(plan.DowntimeScheduleOneTimeSchedule, state.DowntimeScheduleOneTimeSchedule) {
+			plan.NextOccurrences = state.NextOccurrences
+			response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+			return
+		}
+	}
+
+	nextOccurrences, diags := downtimeScheduleNextOccurrences(&plan, r.Now())
+	response.Diagnostics.Append(diags...)
+	if nextOccurrences.IsUnknown() {
+		return
+	}
+	plan.NextOccurrences = nextOccurrences
+	response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+}
+
 func (r *DowntimeScheduleResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	var state DowntimeScheduleModel
 	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
@@ -200,6 +257,11 @@ func (r *DowntimeScheduleResource) Read(ctx context.Context, request resource.Re
 	id := state.ID.ValueString()
 	resp, httpResp, err := r.Api.GetDowntime(r.Auth, id)
 	if err != nil {
+		if isDowntimeV1ID(id) {
+			// The downtime was moved from a `datadog_downtime`, and its v1 ID doesn't resolve to a v2 downtime
+			response.Diagnostics.Append(utils.FrameworkErrorDiag(err, fmt.Sprintf("error retrieving the downtime moved from v1 downtime %s, remove the `moved` block and import the downtime with its v2 ID instead", id)))
+			return
+		}
 		if httpResp != nil && httpResp.StatusCode == 404 {
 			response.State.RemoveResource(ctx)
 			return
@@ -208,12 +270,41 @@ func (r *DowntimeScheduleResource) Read(ctx context.Context, request resource.Re
 		return
 	}
 
+	var diags diag.Diagnostics
 	r.updateState(ctx, &state, &resp)
+	state.NextOccurrences, diags = downtimeScheduleNextOccurrences(&state, r.Now())
+	response.Diagnostics.Append(diags...)
 
 	// Save data into Terraform state
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
 
+func (r *DowntimeScheduleResource) MoveState(context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{StateMover: r.moveDowntimeState},
+	}
+}
+
+// moveDowntimeState moves a v1 `datadog_downtime` resource to the v2 shape. The moved state keeps the v1 ID, which the
+// API resolves to the v2 downtime on the next read.
+func (r *DowntimeScheduleResource) moveDowntimeState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if req.SourceTypeName != "datadog_downtime" || req.SourceRawState == nil {
+		return
+	}
+
+	var source downtimeV1State
+	if err := json.Unmarshal(req.SourceRawState.JSON, &source); err != nil {
+		resp.Diagnostics.AddError("error reading source state", err.Error())
+		return
+	}
+	state, err := source.toDowntimeSchedule(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("unable to move downtime %s", source.ID), err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, state)...)
+}
+
 func (r *DowntimeScheduleResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	var state DowntimeScheduleModel
 	response.Diagnostics.Append(request.Plan.Get(ctx, &state)...)
@@ -233,6 +324,10 @@ func (r *DowntimeScheduleResource) Create(ctx context.Context, request resource.
 		return
 	}
 	r.updateState(ctx, &state, &resp)
+	if state.NextOccurrences.IsUnknown() {
+		state.NextOccurrences, diags = downtimeScheduleNextOccurrences(&state, r.Now())
+		response.Diagnostics.Append(diags...)
+	}
 
 	// Save data into Terraform state
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
@@ -259,6 +354,10 @@ func (r *DowntimeScheduleResource) Update(ctx context.Context, request resource.
 		return
 	}
 	r.updateState(ctx, &state, &resp)
+	if state.NextOccurrences.IsUnknown() {
+		state.NextOccurrences, diags = downtimeScheduleNextOccurrences(&state, r.Now())
+		response.Diagnostics.Append(diags...)
+	}
 
 	// Save data into Terraform state
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
@@ -593,3 +692,234 @@ func (r *DowntimeScheduleResource) buildDowntimeScheduleUpdateRequestBody(ctx co
 
 	return req, diags
 }
+
+// downtimeScheduleNextOccurrences previews the next windows of the downtime, or returns an unknown list when the
+// schedule isn't known yet.
+func downtimeScheduleNextOccurrences(state *DowntimeScheduleModel, now time.Time) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	unknown := types.ListUnknown(fwutils.OccurrenceWindowType)
+
+	var windows []rrule.Window
+	loc := time.UTC
+	if oneTime := state.DowntimeScheduleOneTimeSchedule; oneTime != nil {
+		if oneTime.Start.IsUnknown() || oneTime.End.IsUnknown() {
+			return unknown, diags
+		}
+		window := rrule.Window{}
+		if start, err := time.Parse("2006-01-02T15:04:05Z", oneTime.Start.ValueString()); err == nil {
+			window.Start = start
+		}
+		if !oneTime.End.IsNull() {
+			end, err := time.Parse("2006-01-02T15:04:05Z", oneTime.End.ValueString())
+			if err != nil {
+				return types.ListNull(fwutils.OccurrenceWindowType), diags
+			}
+			window.End = end
+		}
+		if window.End.IsZero() || window.End.After(now) {
+			windows = append(windows, window)
+		}
+	}
+	if recurring := state.DowntimeScheduleRecurrenceSchedule; recurring != nil {
+		if recurring.Timezone.IsUnknown() {
+			return unknown, diags
+		}
+		var err error
+		if loc, err = rrule.Location(recurring.Timezone.ValueString()); err != nil {
+			diags.AddAttributeError(frameworkPath.Root("recurring_schedule").AtName("timezone"), "invalid timezone", err.Error())
+			return types.ListNull(fwutils.OccurrenceWindowType), diags
+		}
+		for _, recurrence := range recurring.Recurrences {
+			if recurrence.Rrule.IsUnknown() || recurrence.Start.IsUnknown() || recurrence.Duration.IsUnknown() {
+				return unknown, diags
+			}
+			rule, err := rrule.Downtimes.Parse(recurrence.Rrule.ValueString())
+			if err != nil {
+				// Rules which can't be previewed, for example ones set outside of Terraform, don't prevent planning
+				return types.ListNull(fwutils.OccurrenceWindowType), diags
+			}
+			duration, err := parseDowntimeDuration(recurrence.Duration.ValueString())
+			if err != nil {
+				return types.ListNull(fwutils.OccurrenceWindowType), diags
+			}
+			dtstart := now.In(loc)
+			if !recurrence.Start.IsNull() {
+				if dtstart, err = time.ParseInLocation("2006-01-02T15:04:05", recurrence.Start.ValueString(), loc); err != nil {
+					return types.ListNull(fwutils.OccurrenceWindowType), diags
+				}
+			}
+			windows = append(windows, rule.Windows(dtstart, duration, now, downtimeScheduleNextOccurrencesCount)...)
+		}
+		sort.SliceStable(windows, func(i, j int) bool { return windows[i].Start.Before(windows[j].Start) })
+	}
+
+	return fwutils.ToTerraformOccurrenceWindows(windows, loc, downtimeScheduleNextOccurrencesCount)
+}
+
+// parseDowntimeDuration parses durations such as `30m`, `2h`, `1d` or `1w`.
+func parseDowntimeDuration(s string) (time.Duration, error) {
+	units := map[byte]time.Duration{'m': time.Minute, 'h': time.Hour, 'd': 24 * time.Hour, 'w': 7 * 24 * time.Hour}
+	if len(s) < 2 {
+		return 0, fmt.Errorf("invalid duration `%s`", s)
+	}
+	unit, ok := units[s[len(s)-1]]
+	if !ok {
+		return 0, fmt.Errorf("invalid duration `%s`", s)
+	}
+	n, err := strconv.Atoi(s[:len(s)-1])
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid duration `%s`", s)
+	}
+	return time.Duration(n) * unit, nil
+}
+
+// downtimeV1State is the state of a v1 `datadog_downtime` resource.
+type downtimeV1State struct {
+	ID                            string   `json:"id"`
+	Scope                         []string `json:"scope"`
+	Start                         int64    `json:"start"`
+	StartDate                     string   `json:"start_date"`
+	End                           int64    `json:"end"`
+	EndDate                       string   `json:"end_date"`
+	Timezone                      string   `json:"timezone"`
+	Message                       string   `json:"message"`
+	MonitorId                     int64    `json:"monitor_id"`
+	MonitorTags                   []string `json:"monitor_tags"`
+	MuteFirstRecoveryNotification bool     `json:"mute_first_recovery_notification"`
+	Recurrence                    []struct {
+		Type             string   `json:"type"`
+		Period           int64    `json:"period"`
+		WeekDays         []string `json:"week_days"`
+		UntilDate        int64    `json:"until_date"`
+		UntilOccurrences int64    `json:"until_occurrences"`
+		Rrule            string   `json:"rrule"`
+	} `json:"recurrence"`
+}
+
+var downtimeV1Frequencies = map[string]string{
+	"days":   "DAILY",
+	"weeks":  "WEEKLY",
+	"months": "MONTHLY",
+	"years":  "YEARLY",
+}
+
+func (v1 *downtimeV1State) toDowntimeSchedule(ctx context.Context) (*DowntimeScheduleModel, error) {
+	start, end := v1.Start, v1.End
+	if t, err := time.Parse(time.RFC3339, v1.StartDate); err == nil {
+		start = t.Unix()
+	}
+	if t, err := time.Parse(time.RFC3339, v1.EndDate); err == nil {
+		end = t.Unix()
+	}
+	timezone := v1.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+
+	// A v1 downtime applies to the sources matching all its scopes
+	scope := strings.Join(v1.Scope, " AND ")
+	state := &DowntimeScheduleModel{
+		ID:                            types.StringValue(v1.ID),
+		DisplayTimezone:               types.StringValue(timezone),
+		Message:                       types.StringNull(),
+		MuteFirstRecoveryNotification: types.BoolValue(v1.MuteFirstRecoveryNotification),
+		Scope:                         types.StringValue(scope),
+		NotifyEndStates:               types.SetNull(types.StringType),
+		NotifyEndTypes:                types.SetNull(types.StringType),
+		MonitorIdentifier: &MonitorIdentifierModel{
+			DowntimeMonitorIdentifierId:   types.Int64Null(),
+			DowntimeMonitorIdentifierTags: types.SetNull(types.StringType),
+		},
+		NextOccurrences: types.ListNull(fwutils.OccurrenceWindowType),
+	}
+	if message := strings.TrimSpace(v1.Message); message != "" {
+		state.Message = types.StringValue(message)
+	}
+	if v1.MonitorId != 0 {
+		state.MonitorIdentifier.DowntimeMonitorIdentifierId = types.Int64Value(v1.MonitorId)
+	} else {
+		// v1 downtimes without monitor tags apply to every monitor
+		monitorTags := v1.MonitorTags
+		if len(monitorTags) == 0 {
+			monitorTags = []string{"*"}
+		}
+		state.MonitorIdentifier.DowntimeMonitorIdentifierTags, _ = types.SetValueFrom(ctx, types.StringType, monitorTags)
+	}
+
+	if len(v1.Recurrence) == 0 {
+		state.DowntimeScheduleOneTimeSchedule = &DowntimeScheduleOneTimeSchedule{
+			Start: types.StringValue(time.Unix(start, 0).UTC().Format("2006-01-02T15:04:05Z")),
+			End:   types.StringNull(),
+		}
+		if end != 0 {
+			state.DowntimeScheduleOneTimeSchedule.End = types.StringValue(time.Unix(end, 0).UTC().Format("2006-01-02T15:04:05Z"))
+		}
+		return state, nil
+	}
+
+	recurrence := v1.Recurrence[0]
+	if end <= start {
+		return nil, fmt.Errorf("recurring downtimes need an `end` after their `start` to define the duration of each occurrence")
+	}
+	rule := recurrence.Rrule
+	if recurrence.Type != "rrule" {
+		freq, ok := downtimeV1Frequencies[recurrence.Type]
+		if !ok {
+			return nil, fmt.Errorf("unsupported recurrence type `%s`", recurrence.Type)
+		}
+		period := recurrence.Period
+		if period == 0 {
+			period = 1
+		}
+		rule = fmt.Sprintf("FREQ=%s;INTERVAL=%d", freq, period)
+		if len(recurrence.WeekDays) > 0 {
+			days := make([]string, len(recurrence.WeekDays))
+			for i, day := range recurrence.WeekDays {
+				days[i] = strings.ToUpper(day[:2])
+			}
+			rule += ";BYDAY=" + strings.Join(days, ",")
+		}
+		if recurrence.UntilOccurrences != 0 {
+			rule += fmt.Sprintf(";COUNT=%d", recurrence.UntilOccurrences)
+		}
+		if recurrence.UntilDate != 0 {
+			rule += ";UNTIL=" + time.Unix(recurrence.UntilDate, 0).UTC().Format("20060102T150405Z")
+		}
+	}
+	if _, err := rrule.Downtimes.Parse(rule); err != nil {
+		return nil, fmt.Errorf("invalid recurrence `%s`: %s", rule, err)
+	}
+	loc, err := rrule.Location(timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	state.DowntimeScheduleRecurrenceSchedule = &DowntimeScheduleRecurrenceSchedule{
+		Timezone: types.StringValue(timezone),
+		Recurrences: []*RecurrencesModel{{
+			Duration: types.StringValue(formatDowntimeDuration(time.Duration(end-start) * time.Second)),
+			Rrule:    types.StringValue(rule),
+			Start:    types.StringValue(time.Unix(start, 0).In(loc).Format("2006-01-02T15:04:05")),
+		}},
+	}
+	return state, nil
+}
+
+// formatDowntimeDuration formats a duration with the largest unit of parseDowntimeDuration which represents it exactly.
+func formatDowntimeDuration(d time.Duration) string {
+	for _, unit := range []struct {
+		suffix   string
+		duration time.Duration
+	}{{"w", 7 * 24 * time.Hour}, {"d", 24 * time.Hour}, {"h", time.Hour}} {
+		if d%unit.duration == 0 {
+			return fmt.Sprintf("%d%s", d/unit.duration, unit.suffix)
+		}
+	}
+	return fmt.Sprintf("%dm", (d+time.Minute-1)/time.Minute)
+}
+
+// isDowntimeV1ID returns whether the ID is the numeric ID of a v1 downtime, rather than the UUID of a v2 downtime.
+func isDowntimeV1ID(id string) bool {
+	_, err := strconv.ParseInt(id, 10, 64)
+	return err == nil
+}
@@ -2,10 +2,15 @@ package fwprovider
 
 import (
 	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
 	"time"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -18,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/fwutils"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/rrule"
 	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
 	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
 )
@@ -25,11 +32,13 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = &onCallScheduleResource{}
 	_ resource.ResourceWithImportState = &onCallScheduleResource{}
+	_ resource.ResourceWithModifyPlan  = &onCallScheduleResource{}
 )
 
 type onCallScheduleResource struct {
 	Api  *datadogV2.OnCallApi
 	Auth context.Context
+	Now  func() time.Time
 }
 
 type onCallScheduleModel struct {
@@ -38,8 +47,18 @@ type onCallScheduleModel struct {
 	TimeZone types.String   `tfsdk:"time_zone"`
 	Teams    types.List     `tfsdk:"teams"`
 	Layers   []*layersModel `tfsdk:"layer"`
+
+	CoverageHorizonDays types.Int64 `tfsdk:"coverage_horizon_days"`
+	RequireFullCoverage types.Bool  `tfsdk:"require_full_coverage"`
+	CoverageGaps        types.List  `tfsdk:"coverage_gaps"`
 }
 
+// Default and maximum number of days checked for `coverage_gaps`.
+const (
+	onCallScheduleCoverageHorizonDays    = 28
+	onCallScheduleMaxCoverageHorizonDays = 90
+)
+
 type layersModel struct {
 	Id            types.String         `tfsdk:"id"`
 	EffectiveDate timetypes.RFC3339    `tfsdk:"effective_date"`
@@ -95,6 +114,7 @@ func (r *onCallScheduleResource) Configure(_ context.Context, request resource.C
 	providerData, _ := request.ProviderData.(*FrameworkProvider)
 	r.Api = providerData.DatadogApiInstances.GetOnCallApiV2()
 	r.Auth = providerData.Auth
+	r.Now = providerData.Now
 }
 
 func (r *onCallScheduleResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -122,6 +142,20 @@ func (r *onCallScheduleResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
 			},
 			"id": utils.ResourceIDAttribute(),
+			"coverage_horizon_days": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("The number of days checked for `coverage_gaps`, from the start of the current day in the schedule's `time_zone`, or from the earliest layer `effective_date` when it is later. Defaults to %d.", onCallScheduleCoverageHorizonDays),
+				Validators:  []validator.Int64{int64validator.Between(1, onCallScheduleMaxCoverageHorizonDays)},
+			},
+			"require_full_coverage": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Fail the plan when `coverage_gaps` isn't empty, that is when the layers leave nobody on call at some point of the coverage horizon.",
+			},
+			"coverage_gaps": schema.ListAttribute{
+				Computed:    true,
+				ElementType: fwutils.OccurrenceWindowType,
+				Description: "The periods of the coverage horizon during which nobody is on call, computed from the layers' `effective_date`, `end_date`, `rotation_start`, `interval`, `restriction` and `users` in the schedule's `time_zone`. Each period has a `start` and an `end` in RFC 3339 format.",
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"layer": schema.ListNestedBlock{
@@ -214,6 +248,44 @@ func (r *onCallScheduleResource) Schema(_ context.Context, _ resource.SchemaRequ
 	}
 }
 
+func (r *onCallScheduleResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan onCallScheduleModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The gaps only change with the layers, so that refreshing them doesn't plan an update
+	var state onCallScheduleModel
+	if !request.State.Raw.IsNull() {
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+	if !request.State.Raw.IsNull() && onCallScheduleCoverageInputsEqual(&plan, &state) {
+		plan.CoverageGaps = state.CoverageGaps
+	} else {
+		var diags diag.Diagnostics
+		plan.CoverageGaps, diags = onCallScheduleCoverageGaps(&plan, r.Now())
+		response.Diagnostics.Append(diags...)
+	}
+
+	if gaps := plan.CoverageGaps.Elements(); plan.RequireFullCoverage.ValueBool() && !plan.CoverageGaps.IsUnknown() && len(gaps) > 0 {
+		first := gaps[0].(types.Object).Attributes()
+		response.Diagnostics.AddAttributeError(frameworkPath.Root("require_full_coverage"), "on-call schedule has coverage gaps",
+			fmt.Sprintf("nobody is on call during %d period(s) of the coverage horizon, the first one from %s to %s. Add or change layers to cover `coverage_gaps`, or unset `require_full_coverage`.",
+				len(gaps), first["start"].(types.String).ValueString(), first["end"].(types.String).ValueString()))
+		return
+	}
+
+	response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+}
+
 func (r *onCallScheduleResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, frameworkPath.Root("id"), request, response)
 }
@@ -244,6 +316,11 @@ func (r *onCallScheduleResource) Read(ctx context.Context, request resource.Read
 	}
 
 	newState := *r.newState(ctx, &resp, &state)
+	newState.CoverageHorizonDays = state.CoverageHorizonDays
+	newState.RequireFullCoverage = state.RequireFullCoverage
+	var diags diag.Diagnostics
+	newState.CoverageGaps, diags = onCallScheduleCoverageGaps(&newState, r.Now())
+	response.Diagnostics.Append(diags...)
 
 	// Save data into Terraform state
 	response.Diagnostics.Append(response.State.Set(ctx, &newState)...)
@@ -281,6 +358,7 @@ func (r *onCallScheduleResource) Create(ctx context.Context, request resource.Cr
 	}
 
 	state := r.newState(ctx, &resp, nil)
+	response.Diagnostics.Append(state.setCoverage(&plan, r.Now())...)
 
 	// Save data into Terraform state
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
@@ -344,6 +422,7 @@ func (r *onCallScheduleResource) Update(ctx context.Context, request resource.Up
 		return
 	}
 	state := r.newState(ctx, &resp, &previousState)
+	response.Diagnostics.Append(state.setCoverage(&plan, r.Now())...)
 
 	// Save data into Terraform state
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
@@ -794,3 +873,265 @@ func buildUpdateRelationships(plannedTeams []string) *datadogV2.ScheduleUpdateRe
 	}
 	return relationships
 }
+
+// setCoverage copies the coverage settings of the plan, and its gaps when they were known at plan time.
+func (m *onCallScheduleModel) setCoverage(plan *onCallScheduleModel, now time.Time) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.CoverageHorizonDays = plan.CoverageHorizonDays
+	m.RequireFullCoverage = plan.RequireFullCoverage
+	m.CoverageGaps = plan.CoverageGaps
+	if m.CoverageGaps.IsUnknown() {
+		m.CoverageGaps, diags = onCallScheduleCoverageGaps(m, now)
+	}
+	return diags
+}
+
+// onCallScheduleCoverageInputsEqual reports whether two schedules have the same coverage, ignoring computed layer IDs.
+func onCallScheduleCoverageInputsEqual(a, b *onCallScheduleModel) bool {
+	if !a.TimeZone.Equal(b.TimeZone) || !a.CoverageHorizonDays.Equal(b.CoverageHorizonDays) || len(a.Layers) != len(b.Layers) {
+		return false
+	}
+	for i := range a.Layers {
+		x, y := *a.Layers[i], *b.Layers[i]
+		x.Id, y.Id = types.StringNull(), types.StringNull()
+		if !reflect.DeepEqual(x, y) {
+			return false
+		}
+	}
+	return true
+}
+
+// onCallCoverageLayer is a layer of an on-call schedule, resolved for computing its coverage.
+type onCallCoverageLayer struct {
+	from          time.Time
+	until         time.Time
+	rotationStart time.Time
+	period        time.Duration
+	// onCall tells for each rotation member whether it is a user, or nobody.
+	onCall []bool
+	// restrictions are [start, end) offsets in seconds from the start of the week, Sunday midnight in the schedule's time zone.
+	restrictions [][2]int
+}
+
+var onCallWeekdays = map[string]time.Weekday{
+	string(datadogV2.WEEKDAY_SUNDAY):    time.Sunday,
+	string(datadogV2.WEEKDAY_MONDAY):    time.Monday,
+	string(datadogV2.WEEKDAY_TUESDAY):   time.Tuesday,
+	string(datadogV2.WEEKDAY_WEDNESDAY): time.Wednesday,
+	string(datadogV2.WEEKDAY_THURSDAY):  time.Thursday,
+	string(datadogV2.WEEKDAY_FRIDAY):    time.Friday,
+	string(datadogV2.WEEKDAY_SATURDAY):  time.Saturday,
+}
+
+// onCallScheduleCoverageGaps computes the periods of the coverage horizon during which no layer has somebody on call.
+// It returns an unknown list when the layers aren't known yet, and a null list when they can't be resolved.
+func onCallScheduleCoverageGaps(m *onCallScheduleModel, now time.Time) (types.List, diag.Diagnostics) {
+	if m.TimeZone.IsUnknown() || m.CoverageHorizonDays.IsUnknown() {
+		return types.ListUnknown(fwutils.OccurrenceWindowType), nil
+	}
+	loc, err := rrule.Location(m.TimeZone.ValueString())
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddAttributeError(frameworkPath.Root("time_zone"), "invalid time zone", err.Error())
+		return types.ListNull(fwutils.OccurrenceWindowType), diags
+	}
+	horizonDays := int64(onCallScheduleCoverageHorizonDays)
+	if !m.CoverageHorizonDays.IsNull() {
+		horizonDays = m.CoverageHorizonDays.ValueInt64()
+	}
+
+	layers := make([]onCallCoverageLayer, 0, len(m.Layers))
+	for _, layer := range m.Layers {
+		coverageLayer, known, ok := newOnCallCoverageLayer(layer)
+		if !known {
+			return types.ListUnknown(fwutils.OccurrenceWindowType), nil
+		}
+		if !ok {
+			return types.ListNull(fwutils.OccurrenceWindowType), nil
+		}
+		layers = append(layers, coverageLayer)
+	}
+
+	// The horizon starts with the day, so that the gaps computed when planning and applying match. Schedules which
+	// don't apply yet are checked from when they start.
+	today := now.In(loc)
+	today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, loc)
+	start := today
+	for i, layer := range layers {
+		if i == 0 || layer.from.Before(start) {
+			start = layer.from
+		}
+	}
+	if start.Before(today) {
+		start = today
+	}
+	end := start.AddDate(0, 0, int(horizonDays))
+
+	gaps := onCallCoverageGaps(layers, loc, start, end)
+	return fwutils.ToTerraformOccurrenceWindows(gaps, loc, len(gaps))
+}
+
+// newOnCallCoverageLayer resolves a layer, known is false when some of its fields aren't known yet.
+func newOnCallCoverageLayer(layer *layersModel) (coverageLayer onCallCoverageLayer, known bool, ok bool) {
+	if layer.EffectiveDate.IsUnknown() || layer.EndDate.IsUnknown() || layer.RotationStart.IsUnknown() {
+		return coverageLayer, false, false
+	}
+	if layer.Interval == nil {
+		return coverageLayer, true, false
+	}
+	if layer.Interval.Days.IsUnknown() || layer.Interval.Seconds.IsUnknown() {
+		return coverageLayer, false, false
+	}
+	for _, restriction := range layer.Restrictions {
+		if restriction.StartDay.IsUnknown() || restriction.StartTime.IsUnknown() || restriction.EndDay.IsUnknown() || restriction.EndTime.IsUnknown() {
+			return coverageLayer, false, false
+		}
+	}
+
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+	coverageLayer.from, d = layer.EffectiveDate.ValueRFC3339Time()
+	diags.Append(d...)
+	coverageLayer.rotationStart, d = layer.RotationStart.ValueRFC3339Time()
+	diags.Append(d...)
+	if !layer.EndDate.IsNull() {
+		coverageLayer.until, d = layer.EndDate.ValueRFC3339Time()
+		diags.Append(d...)
+	}
+	if diags.HasError() {
+		return coverageLayer, true, false
+	}
+	coverageLayer.period = time.Duration(layer.Interval.Days.ValueInt32())*24*time.Hour + time.Duration(layer.Interval.Seconds.ValueInt64())*time.Second
+
+	// Users which aren't known yet are users, only null members leave nobody on call
+	for _, user := range layer.Users {
+		coverageLayer.onCall = append(coverageLayer.onCall, !user.IsNull())
+	}
+
+	for _, restriction := range layer.Restrictions {
+		startOffset, startOk := onCallWeekOffset(restriction.StartDay.ValueString(), restriction.StartTime.ValueString())
+		endOffset, endOk := onCallWeekOffset(restriction.EndDay.ValueString(), restriction.EndTime.ValueString())
+		if !startOk || !endOk {
+			return coverageLayer, true, false
+		}
+		coverageLayer.restrictions = append(coverageLayer.restrictions, [2]int{startOffset, endOffset})
+	}
+	return coverageLayer, true, true
+}
+
+// onCallWeekOffset converts a weekday and a time of day (hh:mm:ss) to an offset in seconds from the start of the week.
+func onCallWeekOffset(day string, timeOfDay string) (int, bool) {
+	weekday, ok := onCallWeekdays[day]
+	if !ok {
+		return 0, false
+	}
+	t, err := time.Parse("15:04:05", timeOfDay)
+	if err != nil {
+		return 0, false
+	}
+	return int(weekday)*24*60*60 + t.Hour()*60*60 + t.Minute()*60 + t.Second(), true
+}
+
+// onCallCoverageGaps splits [start, end) at every time the coverage of a layer may change, and merges the periods
+// during which nobody is on call.
+func onCallCoverageGaps(layers []onCallCoverageLayer, loc *time.Location, start, end time.Time) []rrule.Window {
+	boundaries := []time.Time{start, end}
+	add := func(t time.Time) {
+		if t.After(start) && t.Before(end) {
+			boundaries = append(boundaries, t)
+		}
+	}
+
+	firstWeek := start.In(loc)
+	firstWeek = time.Date(firstWeek.Year(), firstWeek.Month(), firstWeek.Day()-int(firstWeek.Weekday()), 0, 0, 0, 0, loc)
+	for _, layer := range layers {
+		add(layer.from)
+		if !layer.until.IsZero() {
+			add(layer.until)
+		}
+
+		// Handoffs only change the coverage when some members are nobody
+		if layer.period > 0 && slices.Contains(layer.onCall, false) && slices.Contains(layer.onCall, true) {
+			handoff := layer.rotationStart.Add(time.Duration(onCallRotationIndex(start.Sub(layer.rotationStart), layer.period)+1) * layer.period)
+			for ; handoff.Before(end); handoff = handoff.Add(layer.period) {
+				add(handoff)
+			}
+		}
+
+		for week := firstWeek; week.Before(end); week = time.Date(week.Year(), week.Month(), week.Day()+7, 0, 0, 0, 0, loc) {
+			for _, restriction := range layer.restrictions {
+				for _, offset := range restriction {
+					add(time.Date(week.Year(), week.Month(), week.Day(), 0, 0, offset, 0, loc))
+				}
+			}
+		}
+	}
+
+	sort.Slice(boundaries, func(i, j int) bool { return boundaries[i].Before(boundaries[j]) })
+
+	var gaps []rrule.Window
+	for i := 0; i+1 < len(boundaries); i++ {
+		from, to := boundaries[i], boundaries[i+1]
+		if !from.Before(to) || onCallCovered(layers, loc, from) {
+			continue
+		}
+		if n := len(gaps); n > 0 && gaps[n-1].End.Equal(from) {
+			gaps[n-1].End = to
+			continue
+		}
+		gaps = append(gaps, rrule.Window{Start: from, End: to})
+	}
+	return gaps
+}
+
+// onCallCovered reports whether a layer has somebody on call at t.
+func onCallCovered(layers []onCallCoverageLayer, loc *time.Location, t time.Time) bool {
+	for _, layer := range layers {
+		if t.Before(layer.from) || (!layer.until.IsZero() && !t.Before(layer.until)) || len(layer.onCall) == 0 {
+			continue
+		}
+		if !onCallInRestrictions(layer.restrictions, t.In(loc)) {
+			continue
+		}
+		index := 0
+		if layer.period > 0 {
+			index = onCallRotationIndex(t.Sub(layer.rotationStart), layer.period) % len(layer.onCall)
+			if index < 0 {
+				index += len(layer.onCall)
+			}
+		}
+		if layer.onCall[index] {
+			return true
+		}
+	}
+	return false
+}
+
+// onCallRotationIndex returns the number of rotations started since the rotation start, rounded down.
+func onCallRotationIndex(sinceRotationStart time.Duration, period time.Duration) int {
+	index := sinceRotationStart / period
+	if sinceRotationStart < 0 && sinceRotationStart%period != 0 {
+		index--
+	}
+	return int(index)
+}
+
+// onCallInRestrictions reports whether a local time is within the restrictions of a layer, which always applies
+// when it has none.
+func onCallInRestrictions(restrictions [][2]int, t time.Time) bool {
+	if len(restrictions) == 0 {
+		return true
+	}
+	offset := int(t.Weekday())*24*60*60 + t.Hour()*60*60 + t.Minute()*60 + t.Second()
+	for _, restriction := range restrictions {
+		start, end := restriction[0], restriction[1]
+		if start < end && offset >= start && offset < end {
+			return true
+		}
+		// Restrictions ending before they start wrap around the end of the week
+		if start >= end && (offset >= start || offset < end) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,994 @@
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	frameworkPath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/customtypes"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/fwutils"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
+)
+
+var (
+	_ resource.ResourceWithConfigure      = &serviceLevelObjectiveResource{}
+	_ resource.ResourceWithImportState    = &serviceLevelObjectiveResource{}
+	_ resource.ResourceWithValidateConfig = &serviceLevelObjectiveResource{}
+	_ resource.ResourceWithModifyPlan     = &serviceLevelObjectiveResource{}
+	_ resource.ResourceWithUpgradeState   = &serviceLevelObjectiveResource{}
+)
+
+// Timeframes allowed in the thresholds of each type of SLO. The `custom` timeframe is only used to query the
+// history of SLOs.
+var sloAllowedTimeframes = map[datadogV1.SLOType][]datadogV1.SLOTimeframe{
+	datadogV1.SLOTYPE_METRIC:     {datadogV1.SLOTIMEFRAME_SEVEN_DAYS, datadogV1.SLOTIMEFRAME_THIRTY_DAYS, datadogV1.SLOTIMEFRAME_NINETY_DAYS},
+	datadogV1.SLOTYPE_MONITOR:    {datadogV1.SLOTIMEFRAME_SEVEN_DAYS, datadogV1.SLOTIMEFRAME_THIRTY_DAYS, datadogV1.SLOTIMEFRAME_NINETY_DAYS},
+	datadogV1.SLOTYPE_TIME_SLICE: {datadogV1.SLOTIMEFRAME_SEVEN_DAYS, datadogV1.SLOTIMEFRAME_THIRTY_DAYS, datadogV1.SLOTIMEFRAME_NINETY_DAYS},
+}
+
+// Types of monitors which can be used in monitor SLOs.
+var sloSupportedMonitorTypes = []datadogV1.MonitorType{
+	datadogV1.MONITORTYPE_METRIC_ALERT,
+	datadogV1.MONITORTYPE_QUERY_ALERT,
+	datadogV1.MONITORTYPE_SERVICE_CHECK,
+	datadogV1.MONITORTYPE_SYNTHETICS_ALERT,
+}
+
+type serviceLevelObjectiveResource struct {
+	Api         *datadogV1.ServiceLevelObjectivesApi
+	MonitorsApi *datadogV1.MonitorsApi
+	Auth        context.Context
+	DefaultTags map[string]string
+}
+
+type serviceLevelObjectiveModel struct {
+	ID               types.String                     `tfsdk:"id"`
+	Name             types.String                     `tfsdk:"name"`
+	Description      customtypes.TrimSpaceStringValue `tfsdk:"description"`
+	Type             types.String                     `tfsdk:"type"`
+	Tags             types.Set                        `tfsdk:"tags"`
+	EffectiveTags    types.Set                        `tfsdk:"effective_tags"`
+	Timeframe        types.String                     `tfsdk:"timeframe"`
+	TargetThreshold  types.Float64                    `tfsdk:"target_threshold"`
+	WarningThreshold types.Float64                    `tfsdk:"warning_threshold"`
+	ForceDelete      types.Bool                       `tfsdk:"force_delete"`
+	Validate         types.Bool                       `tfsdk:"validate"`
+	Thresholds       []*sloThresholdModel             `tfsdk:"thresholds"`
+	Metric           []*sloMetricModel                `tfsdk:"metric"`
+	Monitor          []*sloMonitorModel               `tfsdk:"monitor"`
+	TimeSlice        []*sloTimeSliceModel             `tfsdk:"time_slice"`
+}
+
+type sloThresholdModel struct {
+	Timeframe      types.String  `tfsdk:"timeframe"`
+	Target         types.Float64 `tfsdk:"target"`
+	TargetDisplay  types.String  `tfsdk:"target_display"`
+	Warning        types.Float64 `tfsdk:"warning"`
+	WarningDisplay types.String  `tfsdk:"warning_display"`
+}
+
+type sloMetricModel struct {
+	Numerator   customtypes.TrimSpaceStringValue `tfsdk:"numerator"`
+	Denominator customtypes.TrimSpaceStringValue `tfsdk:"denominator"`
+}
+
+type sloMonitorModel struct {
+	MonitorIds types.Set `tfsdk:"monitor_ids"`
+	Groups     types.Set `tfsdk:"groups"`
+}
+
+type sloTimeSliceModel struct {
+	Comparator           types.String              `tfsdk:"comparator"`
+	Threshold            types.Float64             `tfsdk:"threshold"`
+	QueryIntervalSeconds types.Int64               `tfsdk:"query_interval_seconds"`
+	Query                []*sloTimeSliceQueryModel `tfsdk:"query"`
+}
+
+type sloTimeSliceQueryModel struct {
+	Formula []*sloFormulaModel         `tfsdk:"formula"`
+	Query   []*sloDataSourceQueryModel `tfsdk:"query"`
+}
+
+type sloFormulaModel struct {
+	FormulaExpression types.String `tfsdk:"formula_expression"`
+}
+
+type sloDataSourceQueryModel struct {
+	MetricQuery []*sloMetricQueryModel `tfsdk:"metric_query"`
+}
+
+type sloMetricQueryModel struct {
+	DataSource types.String `tfsdk:"data_source"`
+	Name       types.String `tfsdk:"name"`
+	Query      types.String `tfsdk:"query"`
+}
+
+// sloData is implemented by both the SLOs returned on creation and update, and the SLOs returned by GetSLO.
+type sloData interface {
+	GetName() string
+	GetDescriptionOk() (*string, bool)
+	GetType() datadogV1.SLOType
+	GetTagsOk() (*[]string, bool)
+	GetThresholds() []datadogV1.SLOThreshold
+	GetTimeframeOk() (*datadogV1.SLOTimeframe, bool)
+	GetTargetThresholdOk() (*float64, bool)
+	GetWarningThresholdOk() (*float64, bool)
+	GetQuery() datadogV1.ServiceLevelObjectiveQuery
+	GetMonitorIds() []int64
+	GetGroups() []string
+	GetSliSpecification() datadogV1.SLOSliSpec
+}
+
+func NewServiceLevelObjectiveResource() resource.Resource {
+	return &serviceLevelObjectiveResource{}
+}
+
+func (r *serviceLevelObjectiveResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	providerData, _ := request.ProviderData.(*FrameworkProvider)
+	r.Api = providerData.DatadogApiInstances.GetServiceLevelObjectivesApiV1()
+	r.MonitorsApi = providerData.DatadogApiInstances.GetMonitorsApiV1()
+	r.Auth = providerData.Auth
+	r.DefaultTags = providerData.DefaultTags
+}
+
+func (r *serviceLevelObjectiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "service_level_objective"
+}
+
+func (r *serviceLevelObjectiveResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Provides a Datadog service level objective resource. This can be used to create and manage Datadog service level objectives. Exactly one of the `metric`, `monitor` or `time_slice` blocks must be set, depending on the type of the service level objective.",
+		Version:     1,
+		Attributes: map[string]schema.Attribute{
+			"id": utils.ResourceIDAttribute(),
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of Datadog service level objective",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				CustomType:  customtypes.TrimSpaceStringType{},
+				Description: "A description of this service level objective.",
+			},
+			"type": schema.StringAttribute{
+				Optional:           true,
+				Computed:           true,
+				Description:        "The type of the service level objective, inferred from the `metric`, `monitor` or `time_slice` block.",
+				DeprecationMessage: "The type is inferred from the `metric`, `monitor` or `time_slice` block, and this attribute will be read-only in a future release.",
+				Validators: []validator.String{
+					validators.NewEnumValidator[validator.String](datadogV1.NewSLOTypeFromValue),
+				},
+			},
+			"tags": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "A list of tags to associate with your service level objective. This can help you categorize and filter service level objectives in the service level objectives page of the UI. **Note**: it's not currently possible to filter by these tags when querying via the API. Removing this attribute removes the tags of the service level objective, only the provider `default_tags` are kept.",
+			},
+			"effective_tags": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The tags of the service level objective, including those inherited from the provider's `default_tags` configuration.",
+			},
+			"timeframe": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The primary time frame for the objective. It must be the time frame of one of the `thresholds`.",
+				Validators: []validator.String{
+					validators.NewEnumValidator[validator.String](datadogV1.NewSLOTimeframeFromValue),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"target_threshold": schema.Float64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The objective's target in `(0,100)`. This must match the target of the threshold of the primary time frame.",
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"warning_threshold": schema.Float64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The objective's warning value in `(0,100)`. This must match the warning of the threshold of the primary time frame.",
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"force_delete": schema.BoolAttribute{
+				Optional:    true,
+				Description: "A boolean indicating whether this service level objective can be deleted even if it's referenced by other resources (for example, dashboards).",
+			},
+			"validate": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether or not to validate the monitors of a monitor SLO during plan. It checks that the monitors exist and are of a type supported by monitor SLOs.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"thresholds": schema.ListNestedBlock{
+				Description: "A list of thresholds and targets that define the service level objectives from the provided SLIs.",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"timeframe": schema.StringAttribute{
+							Required:    true,
+							Description: "The time frame for the objective. Each time frame can only be used once, and must be supported by the type of the service level objective.",
+							Validators: []validator.String{
+								validators.NewEnumValidator[validator.String](datadogV1.NewSLOTimeframeFromValue),
+							},
+						},
+						"target": schema.Float64Attribute{
+							Required:    true,
+							Description: "The objective's target in `(0,100)`.",
+						},
+						"target_display": schema.StringAttribute{
+							Computed:    true,
+							Description: "A string representation of the target that indicates its precision. It uses trailing zeros to show significant decimal places (e.g. `98.00`).",
+						},
+						"warning": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The objective's warning value in `(0,100)`. This must be greater than the target value.",
+						},
+						"warning_display": schema.StringAttribute{
+							Computed:    true,
+							Description: "A string representation of the warning target (see the description of the target_display field for details).",
+						},
+					},
+				},
+			},
+			"metric": schema.ListNestedBlock{
+				Description: "The metric queries of good and total events of a metric SLO.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"numerator": schema.StringAttribute{
+							Required:    true,
+							CustomType:  customtypes.TrimSpaceStringType{},
+							Description: "The sum of all the `good` events.",
+						},
+						"denominator": schema.StringAttribute{
+							Required:    true,
+							CustomType:  customtypes.TrimSpaceStringType{},
+							Description: "The sum of the `total` events.",
+						},
+					},
+				},
+			},
+			"monitor": schema.ListNestedBlock{
+				Description: "The monitors of a monitor SLO.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"monitor_ids": schema.SetAttribute{
+							Required:    true,
+							ElementType: types.Int64Type,
+							Description: "A static set of monitor IDs to use as part of the SLO. The monitors must be metric, query, service check or synthetics monitors.",
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+						"groups": schema.SetAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "A static set of groups to filter the monitors. Only valid with a single monitor.",
+						},
+					},
+				},
+			},
+			"time_slice": schema.ListNestedBlock{
+				Description: "The time slice condition of a time slice SLO, composed of 3 parts: 1. The timeseries query, 2. The comparator, and 3. The threshold. Optionally, a fourth part, the query interval, can be provided.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"comparator": schema.StringAttribute{
+							Required:    true,
+							Description: "The comparator used to compare the SLI value to the threshold.",
+							Validators: []validator.String{
+								validators.NewEnumValidator[validator.String](datadogV1.NewSLOTimeSliceComparatorFromValue),
+							},
+						},
+						"threshold": schema.Float64Attribute{
+							Required:    true,
+							Description: "The threshold value to which each SLI value will be compared.",
+						},
+						"query_interval_seconds": schema.Int64Attribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     int64default.StaticInt64(300),
+							Description: "The interval used when querying data, which defines the size of a time slice.",
+							Validators: []validator.Int64{
+								validators.NewEnumValidator[validator.Int64](datadogV1.NewSLOTimeSliceIntervalFromValue),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"query": schema.ListNestedBlock{
+							Description: "A timeseries query, containing named data-source-specific queries and a formula involving the named queries.",
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeBetween(1, 1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"formula": schema.ListNestedBlock{
+										Description: "A list that contains exactly one formula, as only a single formula may be used to define a timeseries query for a time-slice SLO.",
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeBetween(1, 1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"formula_expression": schema.StringAttribute{
+													Required:    true,
+													Description: "The formula string, which is an expression involving named queries.",
+												},
+											},
+										},
+									},
+									"query": schema.ListNestedBlock{
+										Description: "A list of data-source-specific queries that are in the formula.",
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"metric_query": schema.ListNestedBlock{
+													Description: "A timeseries formula and functions metrics query.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"data_source": schema.StringAttribute{
+																Optional:    true,
+																Computed:    true,
+																Default:     stringdefault.StaticString(string(datadogV1.FORMULAANDFUNCTIONMETRICDATASOURCE_METRICS)),
+																Description: "The data source for metrics queries.",
+																Validators: []validator.String{
+																	validators.NewEnumValidator[validator.String](datadogV1.NewFormulaAndFunctionMetricDataSourceFromValue),
+																},
+															},
+															"query": schema.StringAttribute{
+																Required:    true,
+																Description: "The metrics query definition.",
+															},
+															"name": schema.StringAttribute{
+																Required:    true,
+																Description: "The name of the query for use in formulas.",
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *serviceLevelObjectiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, frameworkPath.Root("id"), request, response)
+}
+
+func (r *serviceLevelObjectiveResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config serviceLevelObjectiveModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	sloType, ok := config.sloType()
+	if !ok {
+		response.Diagnostics.AddError("invalid service level objective", "exactly one of the `metric`, `monitor` or `time_slice` blocks must be set")
+		return
+	}
+	if !config.Type.IsNull() && !config.Type.IsUnknown() && config.Type.ValueString() != string(sloType) {
+		response.Diagnostics.AddAttributeError(frameworkPath.Root("type"), "invalid attribute combination", fmt.Sprintf("`type` must be `%s` for a service level objective with a `%s` block", sloType, sloTypeBlock(sloType)))
+	}
+
+	if len(config.Monitor) == 1 {
+		monitor := config.Monitor[0]
+		if len(monitor.Groups.Elements()) > 0 && !monitor.MonitorIds.IsUnknown() && len(monitor.MonitorIds.Elements()) > 1 {
+			response.Diagnostics.AddAttributeError(frameworkPath.Root("monitor").AtListIndex(0).AtName("groups"), "invalid attribute combination", "`groups` can only be used with a single monitor")
+		}
+	}
+
+	thresholds := make(map[string]*sloThresholdModel)
+	for i, threshold := range config.Thresholds {
+		p := frameworkPath.Root("thresholds").AtListIndex(i)
+		if !threshold.Timeframe.IsUnknown() {
+			timeframe := threshold.Timeframe.ValueString()
+			if !sloTimeframeAllowed(sloType, timeframe) {
+				response.Diagnostics.AddAttributeError(p.AtName("timeframe"), "invalid timeframe", fmt.Sprintf("%s service level objectives only support the %v timeframes, got `%s`", sloType, sloAllowedTimeframes[sloType], timeframe))
+			}
+			if _, ok := thresholds[timeframe]; ok {
+				response.Diagnostics.AddAttributeError(p.AtName("timeframe"), "duplicate timeframe", fmt.Sprintf("the `%s` timeframe is used by more than one threshold", timeframe))
+			}
+			thresholds[timeframe] = threshold
+		}
+		if !threshold.Target.IsUnknown() && !sloPercentageValid(threshold.Target.ValueFloat64()) {
+			response.Diagnostics.AddAttributeError(p.AtName("target"), "invalid target", fmt.Sprintf("`target` must be between 0 and 100 (exclusive), got %v", threshold.Target.ValueFloat64()))
+		}
+		if threshold.Warning.IsNull() || threshold.Warning.IsUnknown() {
+			continue
+		}
+		if !sloPercentageValid(threshold.Warning.ValueFloat64()) {
+			response.Diagnostics.AddAttributeError(p.AtName("warning"), "invalid warning", fmt.Sprintf("`warning` must be between 0 and 100 (exclusive), got %v", threshold.Warning.ValueFloat64()))
+		} else if !threshold.Target.IsUnknown() && threshold.Warning.ValueFloat64() <= threshold.Target.ValueFloat64() {
+			response.Diagnostics.AddAttributeError(p.AtName("warning"), "invalid warning", fmt.Sprintf("`warning` (%v) must be greater than `target` (%v)", threshold.Warning.ValueFloat64(), threshold.Target.ValueFloat64()))
+		}
+	}
+
+	// The primary threshold must match one of the thresholds
+	if config.Timeframe.IsNull() || config.Timeframe.IsUnknown() {
+		return
+	}
+	primary, ok := thresholds[config.Timeframe.ValueString()]
+	if !ok {
+		response.Diagnostics.AddAttributeError(frameworkPath.Root("timeframe"), "invalid timeframe", fmt.Sprintf("`timeframe` must be the timeframe of one of the `thresholds`, got `%s`", config.Timeframe.ValueString()))
+		return
+	}
+	if !config.TargetThreshold.IsNull() && !config.TargetThreshold.IsUnknown() && !primary.Target.IsUnknown() && config.TargetThreshold.ValueFloat64() != primary.Target.ValueFloat64() {
+		response.Diagnostics.AddAttributeError(frameworkPath.Root("target_threshold"), "invalid target_threshold", fmt.Sprintf("`target_threshold` (%v) must match the target of the `%s` threshold (%v)", config.TargetThreshold.ValueFloat64(), config.Timeframe.ValueString(), primary.Target.ValueFloat64()))
+	}
+	if !config.WarningThreshold.IsNull() && !config.WarningThreshold.IsUnknown() && !primary.Warning.IsUnknown() && (primary.Warning.IsNull() || config.WarningThreshold.ValueFloat64() != primary.Warning.ValueFloat64()) {
+		response.Diagnostics.AddAttributeError(frameworkPath.Root("warning_threshold"), "invalid warning_threshold", fmt.Sprintf("`warning_threshold` (%v) must match the warning of the `%s` threshold", config.WarningThreshold.ValueFloat64(), config.Timeframe.ValueString()))
+	}
+}
+
+func (r *serviceLevelObjectiveResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state serviceLevelObjectiveModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if !request.State.Raw.IsNull() {
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	effectiveTags, diags := fwutils.CombineTags(ctx, plan.Tags, r.DefaultTags)
+	response.Diagnostics.Append(diags...)
+	plan.EffectiveTags = effectiveTags
+
+	// The type of an SLO can't be changed
+	if sloType, ok := plan.sloType(); ok {
+		plan.Type = types.StringValue(string(sloType))
+		if !state.Type.IsNull() && state.Type.ValueString() != string(sloType) {
+			response.RequiresReplace = append(response.RequiresReplace, frameworkPath.Root("type"))
+		}
+	}
+
+	// The display of targets only changes with their value
+	for i, threshold := range plan.Thresholds {
+		if i >= len(state.Thresholds) {
+			break
+		}
+		if threshold.Target.Equal(state.Thresholds[i].Target) {
+			threshold.TargetDisplay = state.Thresholds[i].TargetDisplay
+		}
+		if threshold.Warning.Equal(state.Thresholds[i].Warning) {
+			threshold.WarningDisplay = state.Thresholds[i].WarningDisplay
+		}
+	}
+
+	response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if len(plan.Monitor) == 1 && (plan.Validate.IsNull() || plan.Validate.ValueBool()) {
+		response.Diagnostics.Append(r.validateMonitors(ctx, plan.Monitor[0].MonitorIds)...)
+	}
+}
+
+// validateMonitors checks that the monitors of a monitor SLO exist and are of a supported type.
+func (r *serviceLevelObjectiveResource) validateMonitors(ctx context.Context, monitorIds types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if monitorIds.IsUnknown() {
+		return diags
+	}
+	for _, v := range monitorIds.Elements() {
+		id, ok := v.(types.Int64)
+		if !ok || id.IsUnknown() {
+			continue
+		}
+		monitor, httpResp, err := r.MonitorsApi.GetMonitor(r.Auth, id.ValueInt64())
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == 404 {
+				diags.AddAttributeError(frameworkPath.Root("monitor").AtListIndex(0).AtName("monitor_ids"), "error finding monitor to add to SLO", fmt.Sprintf("monitor %d does not exist", id.ValueInt64()))
+			} else {
+				diags.Append(utils.FrameworkErrorDiag(err, "error finding monitor to add to SLO"))
+			}
+			continue
+		}
+		supported := false
+		for _, t := range sloSupportedMonitorTypes {
+			supported = supported || monitor.GetType() == t
+		}
+		if !supported {
+			diags.AddAttributeError(frameworkPath.Root("monitor").AtListIndex(0).AtName("monitor_ids"), "unsupported monitor", fmt.Sprintf("monitor %d is a `%s` monitor, monitor SLOs only support %v monitors", id.ValueInt64(), monitor.GetType(), sloSupportedMonitorTypes))
+		}
+	}
+	return diags
+}
+
+func (r *serviceLevelObjectiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state serviceLevelObjectiveModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, httpResp, err := r.Api.GetSLO(r.Auth, state.ID.ValueString())
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.Append(utils.FrameworkErrorDiag(err, "error getting service level objective"))
+		return
+	}
+	if err := utils.CheckForUnparsed(resp); err != nil {
+		response.Diagnostics.AddError("response contains unparsed object", err.Error())
+		return
+	}
+
+	data := resp.GetData()
+	response.Diagnostics.Append(r.updateState(ctx, &state, &data)...)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *serviceLevelObjectiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan, config serviceLevelObjectiveModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	slo, diags := r.buildSLO(ctx, &plan, &config)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	sloRequest := datadogV1.ServiceLevelObjectiveRequest{
+		Description:      slo.Description,
+		Groups:           slo.Groups,
+		MonitorIds:       slo.MonitorIds,
+		Name:             slo.Name,
+		Query:            slo.Query,
+		SliSpecification: slo.SliSpecification,
+		Tags:             slo.Tags,
+		TargetThreshold:  slo.TargetThreshold,
+		Thresholds:       slo.Thresholds,
+		Timeframe:        slo.Timeframe,
+		Type:             slo.Type,
+		WarningThreshold: slo.WarningThreshold,
+	}
+	// Omit empty tags, so that SLOs without tags don't send any
+	if len(sloRequest.Tags) == 0 {
+		sloRequest.Tags = nil
+	}
+
+	resp, _, err := r.Api.CreateSLO(r.Auth, sloRequest)
+	if err != nil {
+		response.Diagnostics.Append(utils.FrameworkErrorDiag(err, "error creating service level objective"))
+		return
+	}
+	if err := utils.CheckForUnparsed(resp); err != nil {
+		response.Diagnostics.AddError("response contains unparsed object", err.Error())
+		return
+	}
+	if len(resp.GetData()) == 0 {
+		response.Diagnostics.AddError("error creating service level objective", "the response contains no service level objective")
+		return
+	}
+
+	created := resp.GetData()[0]
+	plan.ID = types.StringValue(created.GetId())
+	response.Diagnostics.Append(r.updateState(ctx, &plan, &created)...)
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *serviceLevelObjectiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, config serviceLevelObjectiveModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	slo, diags := r.buildSLO(ctx, &plan, &config)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	slo.SetId(plan.ID.ValueString())
+
+	resp, _, err := r.Api.UpdateSLO(r.Auth, plan.ID.ValueString(), *slo)
+	if err != nil {
+		response.Diagnostics.Append(utils.FrameworkErrorDiag(err, "error updating service level objective"))
+		return
+	}
+	if err := utils.CheckForUnparsed(resp); err != nil {
+		response.Diagnostics.AddError("response contains unparsed object", err.Error())
+		return
+	}
+	if len(resp.GetData()) == 0 {
+		response.Diagnostics.AddError("error updating service level objective", "the response contains no service level objective")
+		return
+	}
+
+	updated := resp.GetData()[0]
+	response.Diagnostics.Append(r.updateState(ctx, &plan, &updated)...)
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *serviceLevelObjectiveResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state serviceLevelObjectiveModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	optionalParams := datadogV1.NewDeleteSLOOptionalParameters()
+	if state.ForceDelete.ValueBool() {
+		optionalParams.WithForce("true")
+	}
+	_, httpResp, err := r.Api.DeleteSLO(r.Auth, state.ID.ValueString(), *optionalParams)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		response.Diagnostics.Append(utils.FrameworkErrorDiag(err, "error deleting service level objective"))
+	}
+}
+
+// buildSLO builds the SLO of the plan. The primary threshold is only sent when it is configured, as it is otherwise
+// computed from the thresholds.
+func (r *serviceLevelObjectiveResource) buildSLO(ctx context.Context, plan *serviceLevelObjectiveModel, config *serviceLevelObjectiveModel) (*datadogV1.ServiceLevelObjective, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	sloType, _ := plan.sloType()
+	slo := datadogV1.NewServiceLevelObjectiveWithDefaults()
+	slo.SetName(plan.Name.ValueString())
+	slo.SetType(sloType)
+	if !plan.Description.IsNull() {
+		slo.SetDescription(plan.Description.ValueString())
+	}
+
+	var tags []string
+	diags.Append(plan.EffectiveTags.ElementsAs(ctx, &tags, false)...)
+	slo.SetTags(sloStringSetOrder(tags))
+
+	thresholds := make([]datadogV1.SLOThreshold, 0, len(plan.Thresholds))
+	for _, t := range plan.Thresholds {
+		threshold := datadogV1.NewSLOThresholdWithDefaults()
+		threshold.SetTimeframe(datadogV1.SLOTimeframe(t.Timeframe.ValueString()))
+		threshold.SetTarget(t.Target.ValueFloat64())
+		if !t.Warning.IsNull() {
+			threshold.SetWarning(t.Warning.ValueFloat64())
+		}
+		thresholds = append(thresholds, *threshold)
+	}
+	slo.SetThresholds(thresholds)
+	if !config.Timeframe.IsNull() {
+		slo.SetTimeframe(datadogV1.SLOTimeframe(plan.Timeframe.ValueString()))
+	}
+	if !config.TargetThreshold.IsNull() {
+		slo.SetTargetThreshold(plan.TargetThreshold.ValueFloat64())
+	}
+	if !config.WarningThreshold.IsNull() {
+		slo.SetWarningThreshold(plan.WarningThreshold.ValueFloat64())
+	}
+
+	switch sloType {
+	case datadogV1.SLOTYPE_MONITOR:
+		monitor := plan.Monitor[0]
+		var monitorIds []int64
+		diags.Append(monitor.MonitorIds.ElementsAs(ctx, &monitorIds, false)...)
+		slo.SetMonitorIds(sloIntSetOrder(monitorIds))
+		if !monitor.Groups.IsNull() {
+			var groups []string
+			diags.Append(monitor.Groups.ElementsAs(ctx, &groups, false)...)
+			slo.SetGroups(sloStringSetOrder(groups))
+		}
+	case datadogV1.SLOTYPE_TIME_SLICE:
+		timeSlice := plan.TimeSlice[0]
+		query := datadogV1.NewSLOTimeSliceQueryWithDefaults()
+		query.Formulas = make([]datadogV1.SLOFormula, 0)
+		query.Queries = make([]datadogV1.SLODataSourceQueryDefinition, 0)
+		for _, q := range timeSlice.Query {
+			for _, formula := range q.Formula {
+				query.Formulas = append(query.Formulas, *datadogV1.NewSLOFormula(formula.FormulaExpression.ValueString()))
+			}
+			for _, dataSourceQuery := range q.Query {
+				for _, metricQuery := range dataSourceQuery.MetricQuery {
+					dataSource := datadogV1.FormulaAndFunctionMetricDataSource(metricQuery.DataSource.ValueString())
+					query.Queries = append(query.Queries, datadogV1.FormulaAndFunctionMetricQueryDefinitionAsSLODataSourceQueryDefinition(
+						datadogV1.NewFormulaAndFunctionMetricQueryDefinition(dataSource, metricQuery.Name.ValueString(), metricQuery.Query.ValueString())))
+				}
+			}
+		}
+		spec := datadogV1.NewSLOTimeSliceSpecWithDefaults()
+		spec.TimeSlice.SetQuery(*query)
+		spec.TimeSlice.SetComparator(datadogV1.SLOTimeSliceComparator(timeSlice.Comparator.ValueString()))
+		spec.TimeSlice.SetThreshold(timeSlice.Threshold.ValueFloat64())
+		spec.TimeSlice.SetQueryIntervalSeconds(datadogV1.SLOTimeSliceInterval(timeSlice.QueryIntervalSeconds.ValueInt64()))
+		slo.SetSliSpecification(datadogV1.SLOTimeSliceSpecAsSLOSliSpec(spec))
+	default:
+		metric := plan.Metric[0]
+		slo.SetQuery(*datadogV1.NewServiceLevelObjectiveQuery(metric.Denominator.ValueString(), metric.Numerator.ValueString()))
+	}
+
+	return slo, diags
+}
+
+func (r *serviceLevelObjectiveResource) updateState(ctx context.Context, state *serviceLevelObjectiveModel, slo sloData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.Name = types.StringValue(slo.GetName())
+	if description, ok := slo.GetDescriptionOk(); ok && description != nil && (*description != "" || !state.Description.IsNull()) {
+		state.Description = customtypes.TrimSpaceStringValue{StringValue: types.StringValue(*description)}
+	} else {
+		state.Description = customtypes.TrimSpaceStringValue{StringValue: types.StringNull()}
+	}
+	state.Type = types.StringValue(string(slo.GetType()))
+	state.EffectiveTags = fwutils.ToTerraformSetString(ctx, slo.GetTagsOk)
+	if state.EffectiveTags.IsNull() {
+		state.EffectiveTags = types.SetValueMust(types.StringType, []attr.Value{})
+	}
+
+	thresholds := make([]*sloThresholdModel, 0)
+	for _, t := range slo.GetThresholds() {
+		threshold := &sloThresholdModel{
+			Timeframe:      types.StringValue(string(t.GetTimeframe())),
+			Target:         types.Float64Value(t.GetTarget()),
+			TargetDisplay:  fwutils.ToTerraformStr(t.GetTargetDisplayOk()),
+			Warning:        types.Float64Null(),
+			WarningDisplay: fwutils.ToTerraformStr(t.GetWarningDisplayOk()),
+		}
+		if warning, ok := t.GetWarningOk(); ok && warning != nil {
+			threshold.Warning = types.Float64Value(*warning)
+		}
+		thresholds = append(thresholds, threshold)
+	}
+	state.Thresholds = thresholds
+	if timeframe, ok := slo.GetTimeframeOk(); ok && timeframe != nil {
+		state.Timeframe = types.StringValue(string(*timeframe))
+	} else if state.Timeframe.IsUnknown() {
+		state.Timeframe = types.StringNull()
+	}
+	if target, ok := slo.GetTargetThresholdOk(); ok && target != nil {
+		state.TargetThreshold = types.Float64Value(*target)
+	} else if state.TargetThreshold.IsUnknown() {
+		state.TargetThreshold = types.Float64Null()
+	}
+	if warning, ok := slo.GetWarningThresholdOk(); ok && warning != nil {
+		state.WarningThreshold = types.Float64Value(*warning)
+	} else {
+		state.WarningThreshold = types.Float64Null()
+	}
+
+	state.Metric, state.Monitor, state.TimeSlice = nil, nil, nil
+	switch slo.GetType() {
+	case datadogV1.SLOTYPE_MONITOR:
+		monitor := &sloMonitorModel{Groups: types.SetNull(types.StringType)}
+		var d diag.Diagnostics
+		monitor.MonitorIds, d = types.SetValueFrom(ctx, types.Int64Type, slo.GetMonitorIds())
+		diags.Append(d...)
+		if len(slo.GetGroups()) > 0 {
+			monitor.Groups, d = types.SetValueFrom(ctx, types.StringType, slo.GetGroups())
+			diags.Append(d...)
+		}
+		state.Monitor = []*sloMonitorModel{monitor}
+	case datadogV1.SLOTYPE_TIME_SLICE:
+		spec := slo.GetSliSpecification()
+		if spec.SLOTimeSliceSpec == nil {
+			break
+		}
+		condition := spec.SLOTimeSliceSpec.GetTimeSlice()
+		query := condition.GetQuery()
+		timeSliceQuery := &sloTimeSliceQueryModel{
+			Formula: make([]*sloFormulaModel, 0),
+			Query:   make([]*sloDataSourceQueryModel, 0),
+		}
+		for _, formula := range query.GetFormulas() {
+			timeSliceQuery.Formula = append(timeSliceQuery.Formula, &sloFormulaModel{FormulaExpression: types.StringValue(formula.GetFormula())})
+		}
+		for _, q := range query.GetQueries() {
+			metricQuery := q.FormulaAndFunctionMetricQueryDefinition
+			if metricQuery == nil {
+				continue
+			}
+			timeSliceQuery.Query = append(timeSliceQuery.Query, &sloDataSourceQueryModel{
+				MetricQuery: []*sloMetricQueryModel{{
+					DataSource: types.StringValue(string(metricQuery.GetDataSource())),
+					Name:       types.StringValue(metricQuery.GetName()),
+					Query:      types.StringValue(metricQuery.GetQuery()),
+				}},
+			})
+		}
+		timeSlice := &sloTimeSliceModel{
+			Comparator:           types.StringValue(string(condition.GetComparator())),
+			Threshold:            types.Float64Value(condition.GetThreshold()),
+			QueryIntervalSeconds: types.Int64Value(int64(condition.GetQueryIntervalSeconds())),
+			Query:                []*sloTimeSliceQueryModel{timeSliceQuery},
+		}
+		state.TimeSlice = []*sloTimeSliceModel{timeSlice}
+	default:
+		query := slo.GetQuery()
+		state.Metric = []*sloMetricModel{{
+			Numerator:   customtypes.TrimSpaceStringValue{StringValue: types.StringValue(query.GetNumerator())},
+			Denominator: customtypes.TrimSpaceStringValue{StringValue: types.StringValue(query.GetDenominator())},
+		}}
+	}
+
+	return diags
+}
+
+// sloType returns the type of the SLO from its block, and whether exactly one block is set.
+func (m *serviceLevelObjectiveModel) sloType() (datadogV1.SLOType, bool) {
+	var sloTypes []datadogV1.SLOType
+	if len(m.Metric) > 0 {
+		sloTypes = append(sloTypes, datadogV1.SLOTYPE_METRIC)
+	}
+	if len(m.Monitor) > 0 {
+		sloTypes = append(sloTypes, datadogV1.SLOTYPE_MONITOR)
+	}
+	if len(m.TimeSlice) > 0 {
+		sloTypes = append(sloTypes, datadogV1.SLOTYPE_TIME_SLICE)
+	}
+	if len(sloTypes) != 1 {
+		return "", false
+	}
+	return sloTypes[0], true
+}
+
+func sloTypeBlock(sloType datadogV1.SLOType) string {
+	if sloType == datadogV1.SLOTYPE_METRIC {
+		return "metric"
+	} else if sloType == datadogV1.SLOTYPE_MONITOR {
+		return "monitor"
+	}
+	return "time_slice"
+}
+
+func sloTimeframeAllowed(sloType datadogV1.SLOType, timeframe string) bool {
+	for _, allowed := range sloAllowedTimeframes[sloType] {
+		if string(allowed) == timeframe {
+			return true
+		}
+	}
+	return false
+}
+
+func sloPercentageValid(value float64) bool {
+	return value > 0 && value < 100
+}
+
+// sloStringSetOrder and sloIntSetOrder order the elements of a set the way the SDKv2 resource did, so that SLOs
+// managed before the migration to the framework keep the order of their tags and monitors.
+func sloStringSetOrder(values []string) []string {
+	items := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		items = append(items, v)
+	}
+	ordered := make([]string, 0, len(values))
+	for _, v := range sdkschema.NewSet(sdkschema.HashSchema(&sdkschema.Schema{Type: sdkschema.TypeString}), items).List() {
+		ordered = append(ordered, v.(string))
+	}
+	return ordered
+}
+
+func sloIntSetOrder(values []int64) []int64 {
+	items := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		items = append(items, int(v))
+	}
+	ordered := make([]int64, 0, len(values))
+	for _, v := range sdkschema.NewSet(sdkschema.HashSchema(&sdkschema.Schema{Type: sdkschema.TypeInt}), items).List() {
+		ordered = append(ordered, int64(v.(int)))
+	}
+	return ordered
+}
+
+// Version 0 is the schema of the SDKv2 resource, where the query of metric SLOs, the monitors of monitor SLOs and the
+// specification of time slice SLOs were top-level attributes.
+func (r *serviceLevelObjectiveResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeServiceLevelObjectiveStateV0},
+	}
+}
+
+func upgradeServiceLevelObjectiveStateV0(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+	if request.RawState == nil || request.RawState.JSON == nil {
+		response.Diagnostics.AddError("missing state", "the state of the service level objective can't be upgraded without its JSON representation")
+		return
+	}
+
+	var prior map[string]interface{}
+	if err := json.Unmarshal(request.RawState.JSON, &prior); err != nil {
+		response.Diagnostics.AddError("error reading state", err.Error())
+		return
+	}
+
+	state := make(map[string]interface{})
+	for _, k := range []string{"id", "name", "type", "timeframe", "target_threshold", "warning_threshold", "force_delete", "validate", "thresholds"} {
+		state[k] = prior[k]
+	}
+	if description, ok := prior["description"].(string); ok && description != "" {
+		state["description"] = description
+	}
+	// Default tags were part of the tags of the SDKv2 resource
+	if tags, ok := prior["tags"].([]interface{}); ok && len(tags) > 0 {
+		state["tags"] = tags
+		state["effective_tags"] = tags
+	} else {
+		state["effective_tags"] = []interface{}{}
+	}
+
+	switch datadogV1.SLOType(fmt.Sprint(prior["type"])) {
+	case datadogV1.SLOTYPE_MONITOR:
+		monitor := map[string]interface{}{"monitor_ids": prior["monitor_ids"]}
+		if groups, ok := prior["groups"].([]interface{}); ok && len(groups) > 0 {
+			monitor["groups"] = groups
+		}
+		state["monitor"] = []interface{}{monitor}
+	case datadogV1.SLOTYPE_TIME_SLICE:
+		if spec, ok := prior["sli_specification"].([]interface{}); ok && len(spec) > 0 {
+			if spec, ok := spec[0].(map[string]interface{}); ok {
+				state["time_slice"] = spec["time_slice"]
+			}
+		}
+	default:
+		state["metric"] = prior["query"]
+	}
+
+	upgraded, err := json.Marshal(state)
+	if err != nil {
+		response.Diagnostics.AddError("error upgrading state", err.Error())
+		return
+	}
+	response.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+}
@@ -0,0 +1,656 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	frameworkPath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+)
+
+var (
+	_ resource.ResourceWithConfigure      = &sloBurnRateAlertsResource{}
+	_ resource.ResourceWithValidateConfig = &sloBurnRateAlertsResource{}
+	_ resource.ResourceWithModifyPlan     = &sloBurnRateAlertsResource{}
+	_ resource.ResourceWithImportState    = &sloBurnRateAlertsResource{}
+)
+
+// Predefined burn rate alert policies. `google_sre` is the multi-window, multi-burn-rate policy from the Google SRE
+// workbook: page when 2% of the error budget is consumed in 1 hour or 5% in 6 hours.
+var sloBurnRateAlertPolicies = map[string][]sloBurnRateAlert{
+	"google_sre": {
+		{Name: "fast", LongWindow: "1h", ShortWindow: "5m", Threshold: 14.4},
+		{Name: "slow", LongWindow: "6h", ShortWindow: "30m", Threshold: 6},
+	},
+}
+
+var sloBurnRateWindowRegex = regexp.MustCompile(`^([1-9][0-9]*)([mhd])$`)
+
+var sloBurnRateQueryRegex = regexp.MustCompile(`^burn_rate\("[^"]*"\)\.over\("[^"]*"\)\.long_window\("([^"]*)"\)\.short_window\("([^"]*)"\) > (\S+)$`)
+
+var sloBurnRateMonitorAttrTypes = map[string]attr.Type{
+	"id":    types.StringType,
+	"name":  types.StringType,
+	"query": types.StringType,
+}
+
+type sloBurnRateAlertsResource struct {
+	MonitorsApi *datadogV1.MonitorsApi
+	SloApi      *datadogV1.ServiceLevelObjectivesApi
+	Auth        context.Context
+}
+
+type sloBurnRateAlertsModel struct {
+	ID       types.String             `tfsdk:"id"`
+	SloID    types.String             `tfsdk:"slo_id"`
+	Policy   types.String             `tfsdk:"policy"`
+	Message  types.String             `tfsdk:"message"`
+	Tags     types.Set                `tfsdk:"tags"`
+	Priority types.Int64              `tfsdk:"priority"`
+	Alerts   []*sloBurnRateAlertModel `tfsdk:"alert"`
+	Monitors types.List               `tfsdk:"monitors"`
+}
+
+type sloBurnRateAlertModel struct {
+	Name        types.String  `tfsdk:"name"`
+	LongWindow  types.String  `tfsdk:"long_window"`
+	ShortWindow types.String  `tfsdk:"short_window"`
+	Threshold   types.Float64 `tfsdk:"threshold"`
+}
+
+type sloBurnRateMonitorModel struct {
+	ID    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Query types.String `tfsdk:"query"`
+}
+
+type sloBurnRateAlert struct {
+	Name        string
+	LongWindow  string
+	ShortWindow string
+	Threshold   float64
+}
+
+func NewSloBurnRateAlertsResource() resource.Resource {
+	return &sloBurnRateAlertsResource{}
+}
+
+func (r *sloBurnRateAlertsResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	providerData, _ := request.ProviderData.(*FrameworkProvider)
+	r.MonitorsApi = providerData.DatadogApiInstances.GetMonitorsApiV1()
+	r.SloApi = providerData.DatadogApiInstances.GetServiceLevelObjectivesApiV1()
+	r.Auth = providerData.Auth
+}
+
+func (r *sloBurnRateAlertsResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "service_level_objective_burn_rate_alerts"
+}
+
+func (r *sloBurnRateAlertsResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Provides a Datadog resource to manage the multi-window burn rate alerts of a service level objective. One `slo alert` monitor is created for each alert of the policy, and the monitors are updated when the name, timeframe or target of the service level objective change. The `message`, `priority` and `tags` are applied to every monitor, and a change to any monitor outside of Terraform is planned back.",
+		Attributes: map[string]schema.Attribute{
+			"id": utils.ResourceIDAttribute(),
+			"slo_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the service level objective to alert on.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy": schema.StringAttribute{
+				Optional:    true,
+				Description: "Predefined burn rate alert policy. `google_sre` alerts on a 14.4 burn rate over 1h and 5m (`fast`), and on a 6 burn rate over 6h and 30m (`slow`). Exactly one of `policy` or `alert` must be set.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("google_sre"),
+				},
+			},
+			"message": schema.StringAttribute{
+				Required:    true,
+				Description: "Message included with the notifications of the monitors, for example `@pagerduty-checkout`.",
+			},
+			"tags": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Tags of the monitors.",
+			},
+			"priority": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Priority of the monitors, from 1 (high) to 5 (low).",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 5),
+				},
+			},
+			"monitors": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.ObjectType{AttrTypes: sloBurnRateMonitorAttrTypes},
+				Description: "The `id`, `name` and `query` of the monitors of the burn rate alerts, in the order of the alerts of the policy.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"alert": schema.ListNestedBlock{
+				Description: "A burn rate alert. The alert triggers when the burn rate over both the long and short windows is above the threshold. Exactly one of `policy` or `alert` must be set.",
+				Validators: []validator.List{
+					listvalidator.ConflictsWith(frameworkPath.MatchRoot("policy")),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Name of the alert, used in the name of the monitor.",
+						},
+						"long_window": schema.StringAttribute{
+							Required:    true,
+							Description: "Long window of the alert. Must be shorter than the timeframe of the service level objective.",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(sloBurnRateWindowRegex, "must be a number of minutes, hours or days, for example `1h`"),
+							},
+						},
+						"short_window": schema.StringAttribute{
+							Required:    true,
+							Description: "Short window of the alert. Must be shorter than `long_window`.",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(sloBurnRateWindowRegex, "must be a number of minutes, hours or days, for example `5m`"),
+							},
+						},
+						"threshold": schema.Float64Attribute{
+							Required:    true,
+							Description: "Burn rate above which the alert triggers. Must be lower than the maximum burn rate of the service level objective, `1 / (1 - target / 100)`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *sloBurnRateAlertsResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config sloBurnRateAlertsModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Policy.IsNull() && len(config.Alerts) == 0 {
+		response.Diagnostics.AddAttributeError(frameworkPath.Root("policy"), "missing attribute", "exactly one of `policy` or `alert` must be set")
+		return
+	}
+
+	names := make(map[string]bool)
+	for i, alert := range config.Alerts {
+		if !alert.Name.IsUnknown() {
+			if names[alert.Name.ValueString()] {
+				response.Diagnostics.AddAttributeError(frameworkPath.Root("alert").AtListIndex(i).AtName("name"), "duplicate alert", fmt.Sprintf("alert `%s` is defined more than once", alert.Name.ValueString()))
+			}
+			names[alert.Name.ValueString()] = true
+		}
+		if alert.LongWindow.IsUnknown() || alert.ShortWindow.IsUnknown() {
+			continue
+		}
+		long, longOk := parseSloBurnRateWindow(alert.LongWindow.ValueString())
+		short, shortOk := parseSloBurnRateWindow(alert.ShortWindow.ValueString())
+		if longOk && shortOk && short >= long {
+			response.Diagnostics.AddAttributeError(frameworkPath.Root("alert").AtListIndex(i).AtName("short_window"), "invalid window", fmt.Sprintf("`short_window` (%s) must be shorter than `long_window` (%s)", alert.ShortWindow.ValueString(), alert.LongWindow.ValueString()))
+		}
+	}
+}
+
+// ModifyPlan validates the alerts against the current timeframe and target of the SLO, and plans an update of the
+// monitors when the SLO changed since they were last applied.
+func (r *sloBurnRateAlertsResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() || r.SloApi == nil {
+		return
+	}
+
+	var plan sloBurnRateAlertsModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() || plan.SloID.IsUnknown() || plan.Policy.IsUnknown() {
+		return
+	}
+	alerts, known := plan.alerts()
+	if !known {
+		return
+	}
+
+	slo, diags := r.getSLO(plan.SloID.ValueString())
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	desired, diags := buildSloBurnRateMonitors(slo, alerts)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() || request.State.Raw.IsNull() {
+		return
+	}
+
+	var state sloBurnRateAlertsModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	var current []*sloBurnRateMonitorModel
+	response.Diagnostics.Append(state.Monitors.ElementsAs(ctx, &current, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	inSync := len(current) == len(desired)
+	for i := 0; inSync && i < len(current); i++ {
+		inSync = !current[i].ID.IsNull() && current[i].Name.ValueString() == desired[i].Name.ValueString() && current[i].Query.ValueString() == desired[i].Query.ValueString()
+	}
+	monitors := types.ListUnknown(types.ObjectType{AttrTypes: sloBurnRateMonitorAttrTypes})
+	if inSync {
+		monitors = state.Monitors
+	}
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, frameworkPath.Root("monitors"), monitors)...)
+}
+
+func (r *sloBurnRateAlertsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state sloBurnRateAlertsModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var current []*sloBurnRateMonitorModel
+	response.Diagnostics.Append(state.Monitors.ElementsAs(ctx, &current, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	imported := state.Monitors.IsNull()
+	if imported {
+		var diags diag.Diagnostics
+		current, diags = r.importedMonitors(state.SloID.ValueString())
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Monitors deleted outside of Terraform are dropped, so that the next plan recreates them
+	monitors := make([]*sloBurnRateMonitorModel, 0, len(current))
+	fetched := make([]datadogV1.Monitor, 0, len(current))
+	for _, m := range current {
+		id, err := strconv.ParseInt(m.ID.ValueString(), 10, 64)
+		if err != nil {
+			continue
+		}
+		monitor, httpResp, err := r.MonitorsApi.GetMonitor(r.Auth, id)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == 404 {
+				continue
+			}
+			response.Diagnostics.Append(utils.FrameworkErrorDiag(err, "error retrieving monitor"))
+			return
+		}
+		if err := utils.CheckForUnparsed(monitor); err != nil {
+			response.Diagnostics.AddError("response contains unparsed object", err.Error())
+			return
+		}
+		if imported && !sloBurnRateQueryRegex.MatchString(monitor.GetQuery()) {
+			continue
+		}
+		fetched = append(fetched, monitor)
+		monitors = append(monitors, &sloBurnRateMonitorModel{
+			ID:    m.ID,
+			Name:  types.StringValue(monitor.GetName()),
+			Query: types.StringValue(monitor.GetQuery()),
+		})
+	}
+
+	if len(fetched) == 0 {
+		if imported {
+			response.Diagnostics.AddError("error importing burn rate alerts", fmt.Sprintf("no burn rate alert monitor found for service level objective %s", state.SloID.ValueString()))
+			return
+		}
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	// The message, priority and tags are shared by all the monitors: the first monitor that differs from the state
+	// is reported, so that a change to any of them is planned back
+	message, priority, tags := state.Message, state.Priority, state.Tags
+	for _, monitor := range fetched {
+		monitorPriority := types.Int64Null()
+		if value, ok := monitor.GetPriorityOk(); ok && value != nil {
+			monitorPriority = types.Int64Value(*value)
+		}
+		monitorTags := types.SetNull(types.StringType)
+		if len(monitor.GetTags()) > 0 {
+			var diags diag.Diagnostics
+			monitorTags, diags = types.SetValueFrom(ctx, types.StringType, monitor.GetTags())
+			response.Diagnostics.Append(diags...)
+		}
+		if message.Equal(state.Message) {
+			message = types.StringValue(monitor.GetMessage())
+		}
+		if priority.Equal(state.Priority) {
+			priority = monitorPriority
+		}
+		if tags.Equal(state.Tags) {
+			tags = monitorTags
+		}
+	}
+	state.Message, state.Priority, state.Tags = message, priority, tags
+	if imported {
+		state.Policy, state.Alerts = sloBurnRateAlertsFromMonitors(fetched)
+	}
+	var diags diag.Diagnostics
+	state.Monitors, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: sloBurnRateMonitorAttrTypes}, monitors)
+	response.Diagnostics.Append(diags...)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *sloBurnRateAlertsResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, frameworkPath.Root("id"), request.ID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, frameworkPath.Root("slo_id"), request.ID)...)
+}
+
+// importedMonitors returns the `slo alert` monitors of the SLO, in the order they were created.
+func (r *sloBurnRateAlertsResource) importedMonitors(sloID string) ([]*sloBurnRateMonitorModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	resp, httpResp, err := r.SloApi.GetSLO(r.Auth, sloID, *datadogV1.NewGetSLOOptionalParameters().WithWithConfiguredAlertIds(true))
+	if err != nil {
+		diags.Append(utils.FrameworkErrorDiag(utils.TranslateClientError(err, httpResp, ""), "error getting service level objective"))
+		return nil, diags
+	}
+	if err := utils.CheckForUnparsed(resp); err != nil {
+		diags.AddError("response contains unparsed object", err.Error())
+		return nil, diags
+	}
+
+	ids := slices.Clone(resp.Data.GetConfiguredAlertIds())
+	slices.Sort(ids)
+	monitors := make([]*sloBurnRateMonitorModel, 0, len(ids))
+	for _, id := range ids {
+		monitors = append(monitors, &sloBurnRateMonitorModel{ID: types.StringValue(strconv.FormatInt(id, 10))})
+	}
+	return monitors, diags
+}
+
+// sloBurnRateAlertsFromMonitors returns the policy or the `alert` blocks matching the names and queries of imported
+// monitors.
+func sloBurnRateAlertsFromMonitors(monitors []datadogV1.Monitor) (types.String, []*sloBurnRateAlertModel) {
+	alerts := make([]sloBurnRateAlert, 0, len(monitors))
+	for _, monitor := range monitors {
+		match := sloBurnRateQueryRegex.FindStringSubmatch(monitor.GetQuery())
+		threshold, _ := strconv.ParseFloat(match[3], 64)
+		name := strings.TrimSuffix(monitor.GetName(), " burn rate")
+		if i := strings.LastIndex(name, " - "); i >= 0 {
+			name = name[i+len(" - "):]
+		}
+		alerts = append(alerts, sloBurnRateAlert{Name: name, LongWindow: match[1], ShortWindow: match[2], Threshold: threshold})
+	}
+
+	for policy, policyAlerts := range sloBurnRateAlertPolicies {
+		if slices.Equal(policyAlerts, alerts) {
+			return types.StringValue(policy), nil
+		}
+	}
+	models := make([]*sloBurnRateAlertModel, 0, len(alerts))
+	for _, alert := range alerts {
+		models = append(models, &sloBurnRateAlertModel{
+			Name:        types.StringValue(alert.Name),
+			LongWindow:  types.StringValue(alert.LongWindow),
+			ShortWindow: types.StringValue(alert.ShortWindow),
+			Threshold:   types.Float64Value(alert.Threshold),
+		})
+	}
+	return types.StringNull(), models
+}
+
+func (r *sloBurnRateAlertsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan sloBurnRateAlertsModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, nil, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = plan.SloID
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *sloBurnRateAlertsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state sloBurnRateAlertsModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var current []*sloBurnRateMonitorModel
+	response.Diagnostics.Append(state.Monitors.ElementsAs(ctx, &current, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, current, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *sloBurnRateAlertsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state sloBurnRateAlertsModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var current []*sloBurnRateMonitorModel
+	response.Diagnostics.Append(state.Monitors.ElementsAs(ctx, &current, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	for _, m := range current {
+		response.Diagnostics.Append(r.deleteMonitor(m.ID.ValueString())...)
+	}
+}
+
+// apply creates or updates the monitors of the alerts of the plan, reusing the current monitors in order, and
+// deletes the current monitors that are no longer needed. The monitors of the plan are set to the applied monitors.
+func (r *sloBurnRateAlertsResource) apply(ctx context.Context, plan *sloBurnRateAlertsModel, current []*sloBurnRateMonitorModel, diags *diag.Diagnostics) {
+	alerts, _ := plan.alerts()
+	slo, d := r.getSLO(plan.SloID.ValueString())
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+	desired, d := buildSloBurnRateMonitors(slo, alerts)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	var tags []string
+	diags.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
+	if diags.HasError() {
+		return
+	}
+	if tags == nil {
+		// Always send the tags, so that removed tags are removed from the monitors
+		tags = make([]string, 0)
+	}
+
+	for i, m := range desired {
+		monitor := datadogV1.NewMonitor(m.Query.ValueString(), datadogV1.MONITORTYPE_SLO_ALERT)
+		monitor.SetName(m.Name.ValueString())
+		monitor.SetMessage(plan.Message.ValueString())
+		monitor.SetTags(tags)
+		if !plan.Priority.IsNull() {
+			monitor.SetPriority(plan.Priority.ValueInt64())
+		}
+		monitor.SetOptions(datadogV1.MonitorOptions{
+			Thresholds: &datadogV1.MonitorThresholds{Critical: datadog.PtrFloat64(alerts[i].Threshold)},
+		})
+
+		var result datadogV1.Monitor
+		var err error
+		if i < len(current) && !current[i].ID.IsNull() {
+			id, _ := strconv.ParseInt(current[i].ID.ValueString(), 10, 64)
+			update := datadogV1.MonitorUpdateRequest{
+				Name:     monitor.Name,
+				Query:    datadog.PtrString(monitor.Query),
+				Message:  monitor.Message,
+				Tags:     tags,
+				Priority: monitor.Priority,
+				Options:  monitor.Options,
+			}
+			if !monitor.Priority.IsSet() {
+				update.SetPriorityNil()
+			}
+			var httpResp *http.Response
+			result, httpResp, err = r.MonitorsApi.UpdateMonitor(r.Auth, id, update)
+			if err != nil && httpResp != nil && httpResp.StatusCode == 404 {
+				result, _, err = r.MonitorsApi.CreateMonitor(r.Auth, *monitor)
+			}
+		} else {
+			result, _, err = r.MonitorsApi.CreateMonitor(r.Auth, *monitor)
+		}
+		if err != nil {
+			diags.Append(utils.FrameworkErrorDiag(err, fmt.Sprintf("error applying monitor of alert `%s`", alerts[i].Name)))
+			return
+		}
+		if err := utils.CheckForUnparsed(result); err != nil {
+			diags.AddError("response contains unparsed object", err.Error())
+			return
+		}
+		m.ID = types.StringValue(strconv.FormatInt(result.GetId(), 10))
+	}
+	for i := len(desired); i < len(current); i++ {
+		diags.Append(r.deleteMonitor(current[i].ID.ValueString())...)
+	}
+
+	plan.Monitors, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: sloBurnRateMonitorAttrTypes}, desired)
+	diags.Append(d...)
+}
+
+func (r *sloBurnRateAlertsResource) deleteMonitor(id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	monitorID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return diags
+	}
+	if _, httpResp, err := r.MonitorsApi.DeleteMonitor(r.Auth, monitorID); err != nil && (httpResp == nil || httpResp.StatusCode != 404) {
+		diags.Append(utils.FrameworkErrorDiag(err, "error deleting monitor"))
+	}
+	return diags
+}
+
+func (r *sloBurnRateAlertsResource) getSLO(id string) (*datadogV1.SLOResponseData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	resp, _, err := r.SloApi.GetSLO(r.Auth, id)
+	if err != nil {
+		diags.Append(utils.FrameworkErrorDiag(err, "error getting service level objective"))
+		return nil, diags
+	}
+	if err := utils.CheckForUnparsed(resp); err != nil {
+		diags.AddError("response contains unparsed object", err.Error())
+		return nil, diags
+	}
+	slo := resp.GetData()
+	return &slo, diags
+}
+
+// alerts returns the alerts of the policy or of the `alert` blocks, and whether they are all known.
+func (m *sloBurnRateAlertsModel) alerts() ([]sloBurnRateAlert, bool) {
+	if !m.Policy.IsNull() {
+		return sloBurnRateAlertPolicies[m.Policy.ValueString()], true
+	}
+	alerts := make([]sloBurnRateAlert, 0, len(m.Alerts))
+	for _, a := range m.Alerts {
+		if a.Name.IsUnknown() || a.LongWindow.IsUnknown() || a.ShortWindow.IsUnknown() || a.Threshold.IsUnknown() {
+			return nil, false
+		}
+		alerts = append(alerts, sloBurnRateAlert{
+			Name:        a.Name.ValueString(),
+			LongWindow:  a.LongWindow.ValueString(),
+			ShortWindow: a.ShortWindow.ValueString(),
+			Threshold:   a.Threshold.ValueFloat64(),
+		})
+	}
+	return alerts, true
+}
+
+// buildSloBurnRateMonitors returns the name and query of the monitor of each alert, after checking the windows
+// against the timeframe of the SLO and the thresholds against its target.
+func buildSloBurnRateMonitors(slo *datadogV1.SLOResponseData, alerts []sloBurnRateAlert) ([]*sloBurnRateMonitorModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	timeframe, target := slo.GetTimeframe(), slo.GetTargetThreshold()
+	if _, ok := slo.GetTimeframeOk(); !ok && len(slo.GetThresholds()) > 0 {
+		timeframe, target = slo.GetThresholds()[0].GetTimeframe(), slo.GetThresholds()[0].GetTarget()
+	}
+	days, ok := sloTimeframeDays[timeframe]
+	if !ok {
+		diags.AddAttributeError(frameworkPath.Root("slo_id"), "unsupported service level objective", fmt.Sprintf("burn rate alerts require a service level objective with a %s, %s or %s timeframe, got `%s`", datadogV1.SLOTIMEFRAME_SEVEN_DAYS, datadogV1.SLOTIMEFRAME_THIRTY_DAYS, datadogV1.SLOTIMEFRAME_NINETY_DAYS, timeframe))
+		return nil, diags
+	}
+	period := time.Duration(days) * 24 * time.Hour
+
+	// A burn rate can't exceed the rate at which the budget is consumed when every event is bad
+	maxBurnRate := 0.0
+	if target < 100 {
+		maxBurnRate = math.Round(100/(100-target)*100) / 100
+	}
+
+	monitors := make([]*sloBurnRateMonitorModel, 0, len(alerts))
+	for _, alert := range alerts {
+		long, _ := parseSloBurnRateWindow(alert.LongWindow)
+		if long >= period {
+			diags.AddError("invalid window", fmt.Sprintf("the long window of alert `%s` (%s) must be shorter than the %s timeframe of the service level objective", alert.Name, alert.LongWindow, timeframe))
+		}
+		if alert.Threshold <= 0 || alert.Threshold >= maxBurnRate {
+			diags.AddError("invalid threshold", fmt.Sprintf("the threshold of alert `%s` (%s) must be between 0 and %s, the maximum burn rate of a %s target", alert.Name, formatSloBurnRate(alert.Threshold), formatSloBurnRate(maxBurnRate), formatSloBurnRate(target)))
+		}
+		monitors = append(monitors, &sloBurnRateMonitorModel{
+			ID:    types.StringUnknown(),
+			Name:  types.StringValue(fmt.Sprintf("%s - %s burn rate", slo.GetName(), alert.Name)),
+			Query: types.StringValue(fmt.Sprintf(`burn_rate("%s").over("%s").long_window("%s").short_window("%s") > %s`, slo.GetId(), timeframe, alert.LongWindow, alert.ShortWindow, formatSloBurnRate(alert.Threshold))),
+		})
+	}
+	return monitors, diags
+}
+
+func parseSloBurnRateWindow(window string) (time.Duration, bool) {
+	match := sloBurnRateWindowRegex.FindStringSubmatch(window)
+	if match == nil {
+		return 0, false
+	}
+	value, _ := strconv.Atoi(match[1])
+	unit := map[string]time.Duration{"m": time.Minute, "h": time.Hour, "d": 24 * time.Hour}[match[2]]
+	return time.Duration(value) * unit, true
+}
+
+func formatSloBurnRate(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,489 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	frameworkPath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/fwutils"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/rrule"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
+)
+
+var (
+	_ resource.ResourceWithConfigure      = &sloCorrectionResource{}
+	_ resource.ResourceWithImportState    = &sloCorrectionResource{}
+	_ resource.ResourceWithValidateConfig = &sloCorrectionResource{}
+	_ resource.ResourceWithModifyPlan     = &sloCorrectionResource{}
+)
+
+// Number of windows previewed in `next_occurrences`.
+const sloCorrectionNextOccurrences = 5
+
+// Corrections are compared over the year following their next window when looking for overlaps.
+const (
+	sloCorrectionOverlapHorizon    = 365 * 24 * time.Hour
+	sloCorrectionOverlapMaxWindows = 1000
+)
+
+type sloCorrectionResource struct {
+	Api    *datadogV1.ServiceLevelObjectiveCorrectionsApi
+	SloApi *datadogV1.ServiceLevelObjectivesApi
+	Auth   context.Context
+	Now    func() time.Time
+}
+
+type sloCorrectionModel struct {
+	ID              types.String `tfsdk:"id"`
+	Category        types.String `tfsdk:"category"`
+	Description     types.String `tfsdk:"description"`
+	SloId           types.String `tfsdk:"slo_id"`
+	Start           types.Int64  `tfsdk:"start"`
+	End             types.Int64  `tfsdk:"end"`
+	Timezone        types.String `tfsdk:"timezone"`
+	Duration        types.Int64  `tfsdk:"duration"`
+	Rrule           types.String `tfsdk:"rrule"`
+	NextOccurrences types.List   `tfsdk:"next_occurrences"`
+}
+
+func NewSloCorrectionResource() resource.Resource {
+	return &sloCorrectionResource{}
+}
+
+func (r *sloCorrectionResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	providerData, _ := request.ProviderData.(*FrameworkProvider)
+	r.Api = providerData.DatadogApiInstances.GetServiceLevelObjectiveCorrectionsApiV1()
+	r.SloApi = providerData.DatadogApiInstances.GetServiceLevelObjectivesApiV1()
+	r.Auth = providerData.Auth
+	r.Now = providerData.Now
+}
+
+func (r *sloCorrectionResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "slo_correction"
+}
+
+func (r *sloCorrectionResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Resource for interacting with the slo_correction API. A warning is shown at plan time when the correction overlaps with another correction of the same SLO.",
+		Attributes: map[string]schema.Attribute{
+			"id": utils.ResourceIDAttribute(),
+			"category": schema.StringAttribute{
+				Required:    true,
+				Description: "Category the SLO correction belongs to.",
+				Validators: []validator.String{
+					validators.NewEnumValidator[validator.String](datadogV1.NewSLOCorrectionCategoryFromValue),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description of the correction being made.",
+			},
+			"end": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Ending time of the correction in epoch seconds. Required for one time corrections, but optional if `rrule` is specified",
+				Validators: []validator.Int64{
+					int64validator.ConflictsWith(frameworkPath.MatchRoot("rrule"), frameworkPath.MatchRoot("duration")),
+				},
+			},
+			"slo_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the SLO that this correction will be applied to.",
+			},
+			"start": schema.Int64Attribute{
+				Required:    true,
+				Description: "Starting time of the correction in epoch seconds.",
+			},
+			"timezone": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The timezone to display in the UI for the correction times. Prefers IANA timezone name format (for example, 'America/Los_Angeles', 'Europe/Paris'), but some common standard abbreviations are supported. Defaults to 'UTC'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"duration": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Length of time in seconds for a specified `rrule` recurring SLO correction (required if specifying `rrule`)",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"rrule": schema.StringAttribute{
+				Optional:    true,
+				Description: "Recurrence rules as defined in the iCalendar RFC 5545. " + rrule.SloCorrections.Description(),
+				Validators: []validator.String{
+					validators.RRuleValidator(rrule.SloCorrections),
+				},
+			},
+			"next_occurrences": schema.ListAttribute{
+				Computed:    true,
+				ElementType: fwutils.OccurrenceWindowType,
+				Description: fmt.Sprintf("The next %d windows during which the correction applies, computed from `start`, `end`, `rrule` and `duration` in the correction's `timezone`. Each window has a `start` and an `end` in RFC 3339 format. Timezones which are not IANA timezone names are computed in UTC.", sloCorrectionNextOccurrences),
+			},
+		},
+	}
+}
+
+func (r *sloCorrectionResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, frameworkPath.Root("id"), request, response)
+}
+
+func (r *sloCorrectionResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config sloCorrectionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Rrule.IsNull() && !config.Rrule.IsUnknown() {
+		if config.Duration.IsNull() {
+			response.Diagnostics.AddAttributeError(frameworkPath.Root("duration"), "missing duration", "`duration` is required when `rrule` is set")
+		}
+	}
+	if !config.End.IsNull() && !config.End.IsUnknown() && !config.Start.IsUnknown() && config.End.ValueInt64() <= config.Start.ValueInt64() {
+		response.Diagnostics.AddAttributeError(frameworkPath.Root("end"), "invalid end", fmt.Sprintf("`end` (%d) must be after `start` (%d)", config.End.ValueInt64(), config.Start.ValueInt64()))
+	}
+}
+
+func (r *sloCorrectionResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state sloCorrectionModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if !request.State.Raw.IsNull() {
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The preview only changes with the schedule, so that refreshing it doesn't plan an update
+	if !request.State.Raw.IsNull() && !sloCorrectionScheduleChanged(&plan, &state) && plan.SloId.Equal(state.SloId) {
+		plan.NextOccurrences = state.NextOccurrences
+		response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+		return
+	}
+	if !sloCorrectionScheduleKnown(&plan) {
+		return
+	}
+
+	// The API accepts timezone abbreviations which aren't part of the tz database, their occurrences can't be previewed
+	if _, err := rrule.Location(plan.Timezone.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeWarning(frameworkPath.Root("timezone"), "next occurrences can't be previewed", err.Error())
+		plan.NextOccurrences = types.ListNull(fwutils.OccurrenceWindowType)
+		response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	now := r.Now()
+	windows, loc, err := sloCorrectionWindows(plan.Start.ValueInt64(), plan.End.ValueInt64Pointer(), plan.Rrule.ValueStringPointer(), plan.Duration.ValueInt64Pointer(), plan.Timezone.ValueString(), now, sloCorrectionOverlapMaxWindows)
+	if err != nil {
+		response.Diagnostics.AddAttributeError(frameworkPath.Root("rrule"), "invalid rrule", err.Error())
+		return
+	}
+	occurrences, diags := fwutils.ToTerraformOccurrenceWindows(windows, loc, sloCorrectionNextOccurrences)
+	response.Diagnostics.Append(diags...)
+	plan.NextOccurrences = occurrences
+	response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+
+	if !plan.SloId.IsUnknown() {
+		response.Diagnostics.Append(r.checkOverlaps(&plan, windows, now)...)
+	}
+}
+
+// checkOverlaps warns about the other corrections of the SLO which overlap with the planned windows.
+func (r *sloCorrectionResource) checkOverlaps(plan *sloCorrectionModel, windows []rrule.Window, now time.Time) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(windows) == 0 {
+		return diags
+	}
+	horizon := windows[0].Start.Add(sloCorrectionOverlapHorizon)
+	windows = sloCorrectionWindowsBefore(windows, horizon)
+
+	resp, httpResp, err := r.SloApi.GetSLOCorrections(r.Auth, plan.SloId.ValueString())
+	if err != nil {
+		if httpResp == nil || httpResp.StatusCode != 404 {
+			diags.AddWarning("unable to check overlapping SLO corrections", err.Error())
+		}
+		return diags
+	}
+	for _, correction := range resp.GetData() {
+		if correction.GetId() == plan.ID.ValueString() {
+			continue
+		}
+		attributes := correction.GetAttributes()
+		var end, duration *int64
+		if v, ok := attributes.GetEndOk(); ok && v != nil {
+			end = v
+		}
+		if v, ok := attributes.GetDurationOk(); ok && v != nil {
+			duration = v
+		}
+		otherWindows, _, err := sloCorrectionWindows(attributes.GetStart(), end, attributes.Rrule.Get(), duration, attributes.GetTimezone(), now, sloCorrectionOverlapMaxWindows)
+		if err != nil {
+			continue
+		}
+		if window, other, ok := rrule.Overlap(windows, sloCorrectionWindowsBefore(otherWindows, horizon)); ok {
+			diags.AddAttributeWarning(frameworkPath.Root("slo_id"), "overlapping SLO corrections",
+				fmt.Sprintf("The window from %s to %s overlaps with the window from %s to %s of correction %s (%s) on the same SLO.",
+					window.Start.Format(time.RFC3339), window.End.Format(time.RFC3339), other.Start.Format(time.RFC3339), other.End.Format(time.RFC3339),
+					correction.GetId(), attributes.GetDescription()))
+		}
+	}
+	return diags
+}
+
+func (r *sloCorrectionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state sloCorrectionModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, httpResp, err := r.Api.GetSLOCorrection(r.Auth, state.ID.ValueString())
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.Append(utils.FrameworkErrorDiag(err, "error reading SloCorrection"))
+		return
+	}
+	if err := utils.CheckForUnparsed(resp); err != nil {
+		response.Diagnostics.AddError("response contains unparsed object", err.Error())
+		return
+	}
+
+	r.updateState(&state, resp.GetData())
+	response.Diagnostics.Append(r.refreshNextOccurrences(&state)...)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *sloCorrectionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan sloCorrectionModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	body := datadogV1.NewSLOCorrectionCreateRequestWithDefaults()
+	data := datadogV1.NewSLOCorrectionCreateData(datadogV1.SLOCORRECTIONTYPE_CORRECTION)
+	attributes := datadogV1.NewSLOCorrectionCreateRequestAttributesWithDefaults()
+	attributes.SetCategory(datadogV1.SLOCorrectionCategory(plan.Category.ValueString()))
+	attributes.SetStart(plan.Start.ValueInt64())
+	if !plan.End.IsNull() {
+		attributes.SetEnd(plan.End.ValueInt64())
+	}
+	attributes.SetSloId(plan.SloId.ValueString())
+	if plan.Timezone.ValueString() != "" {
+		attributes.SetTimezone(plan.Timezone.ValueString())
+	}
+	if plan.Description.ValueString() != "" {
+		attributes.SetDescription(plan.Description.ValueString())
+	}
+	if plan.Rrule.ValueString() != "" {
+		attributes.SetRrule(plan.Rrule.ValueString())
+	}
+	if plan.Duration.ValueInt64() != 0 {
+		attributes.SetDuration(plan.Duration.ValueInt64())
+	}
+	data.SetAttributes(*attributes)
+	body.SetData(*data)
+
+	resp, _, err := r.Api.CreateSLOCorrection(r.Auth, *body)
+	if err != nil {
+		response.Diagnostics.Append(utils.FrameworkErrorDiag(err, "error creating SloCorrection"))
+		return
+	}
+	if err := utils.CheckForUnparsed(resp); err != nil {
+		response.Diagnostics.AddError("response contains unparsed object", err.Error())
+		return
+	}
+
+	correction := resp.GetData()
+	plan.ID = types.StringValue(correction.GetId())
+	r.updateState(&plan, correction)
+	if plan.NextOccurrences.IsUnknown() {
+		response.Diagnostics.Append(r.refreshNextOccurrences(&plan)...)
+	}
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *sloCorrectionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan sloCorrectionModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	body := datadogV1.NewSLOCorrectionUpdateRequestWithDefaults()
+	data := datadogV1.NewSLOCorrectionUpdateData()
+	attributes := datadogV1.NewSLOCorrectionUpdateRequestAttributesWithDefaults()
+	if plan.Description.ValueString() != "" {
+		attributes.SetDescription(plan.Description.ValueString())
+	}
+	if plan.Timezone.ValueString() != "" {
+		attributes.SetTimezone(plan.Timezone.ValueString())
+	}
+	attributes.SetStart(plan.Start.ValueInt64())
+	if !plan.End.IsNull() {
+		attributes.SetEnd(plan.End.ValueInt64())
+	}
+	attributes.SetCategory(datadogV1.SLOCorrectionCategory(plan.Category.ValueString()))
+	if plan.Rrule.ValueString() != "" {
+		attributes.SetRrule(plan.Rrule.ValueString())
+	}
+	if plan.Duration.ValueInt64() != 0 {
+		attributes.SetDuration(plan.Duration.ValueInt64())
+	}
+	data.SetAttributes(*attributes)
+	body.SetData(*data)
+
+	resp, _, err := r.Api.UpdateSLOCorrection(r.Auth, plan.ID.ValueString(), *body)
+	if err != nil {
+		response.Diagnostics.Append(utils.FrameworkErrorDiag(err, "error updating SloCorrection"))
+		return
+	}
+	if err := utils.CheckForUnparsed(resp); err != nil {
+		response.Diagnostics.AddError("response contains unparsed object", err.Error())
+		return
+	}
+
+	r.updateState(&plan, resp.GetData())
+	if plan.NextOccurrences.IsUnknown() {
+		response.Diagnostics.Append(r.refreshNextOccurrences(&plan)...)
+	}
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *sloCorrectionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state sloCorrectionModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.Api.DeleteSLOCorrection(r.Auth, state.ID.ValueString())
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		response.Diagnostics.Append(utils.FrameworkErrorDiag(err, "error deleting SloCorrection"))
+	}
+}
+
+func (r *sloCorrectionResource) updateState(state *sloCorrectionModel, correction datadogV1.SLOCorrection) {
+	attributes, ok := correction.GetAttributesOk()
+	if !ok {
+		return
+	}
+	if category, ok := attributes.GetCategoryOk(); ok {
+		state.Category = types.StringValue(string(*category))
+	}
+	if description, ok := attributes.GetDescriptionOk(); ok && description != nil && (*description != "" || !state.Description.IsNull()) {
+		state.Description = types.StringValue(*description)
+	}
+	if sloId, ok := attributes.GetSloIdOk(); ok {
+		state.SloId = types.StringValue(*sloId)
+	}
+	if timezone, ok := attributes.GetTimezoneOk(); ok && timezone != nil {
+		state.Timezone = types.StringValue(*timezone)
+	} else if state.Timezone.IsUnknown() {
+		state.Timezone = types.StringNull()
+	}
+	if start, ok := attributes.GetStartOk(); ok {
+		state.Start = types.Int64Value(*start)
+	}
+	// end, rrule and duration are nullables, so deal with explicit nulls
+	if end, ok := attributes.GetEndOk(); ok && end != nil {
+		state.End = types.Int64Value(*end)
+	} else {
+		state.End = types.Int64Null()
+	}
+	if rrule, ok := attributes.GetRruleOk(); ok && rrule != nil && *rrule != "" {
+		state.Rrule = types.StringValue(*rrule)
+	} else {
+		state.Rrule = types.StringNull()
+	}
+	if duration, ok := attributes.GetDurationOk(); ok && duration != nil && *duration != 0 {
+		state.Duration = types.Int64Value(*duration)
+	} else {
+		state.Duration = types.Int64Null()
+	}
+}
+
+func (r *sloCorrectionResource) refreshNextOccurrences(state *sloCorrectionModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	windows, loc, err := sloCorrectionWindows(state.Start.ValueInt64(), state.End.ValueInt64Pointer(), state.Rrule.ValueStringPointer(), state.Duration.ValueInt64Pointer(), state.Timezone.ValueString(), r.Now(), sloCorrectionNextOccurrences)
+	if err != nil {
+		// Rules which can't be previewed, for example ones set outside of Terraform, don't prevent reading the correction
+		state.NextOccurrences = types.ListNull(fwutils.OccurrenceWindowType)
+		return diags
+	}
+	state.NextOccurrences, diags = fwutils.ToTerraformOccurrenceWindows(windows, loc, sloCorrectionNextOccurrences)
+	return diags
+}
+
+func sloCorrectionScheduleKnown(m *sloCorrectionModel) bool {
+	return !m.Start.IsUnknown() && !m.End.IsUnknown() && !m.Rrule.IsUnknown() && !m.Duration.IsUnknown() && !m.Timezone.IsUnknown()
+}
+
+func sloCorrectionScheduleChanged(plan *sloCorrectionModel, state *sloCorrectionModel) bool {
+	return !plan.Start.Equal(state.Start) || !plan.End.Equal(state.End) || !plan.Rrule.Equal(state.Rrule) ||
+		!plan.Duration.Equal(state.Duration) || !plan.Timezone.Equal(state.Timezone)
+}
+
+// sloCorrectionWindows returns up to n windows of a correction which end after `after`, and the location in which
+// they are computed. One time corrections have a single window, and corrections without an end never end.
+func sloCorrectionWindows(start int64, end *int64, rule *string, duration *int64, timezone string, after time.Time, n int) ([]rrule.Window, *time.Location, error) {
+	loc, err := rrule.Location(timezone)
+	if err != nil {
+		return nil, nil, err
+	}
+	dtstart := time.Unix(start, 0).In(loc)
+
+	if rule == nil || *rule == "" {
+		if end == nil {
+			return nil, loc, nil
+		}
+		window := rrule.Window{Start: dtstart, End: time.Unix(*end, 0).In(loc)}
+		if !window.End.After(after) {
+			return nil, loc, nil
+		}
+		return []rrule.Window{window}, loc, nil
+	}
+
+	parsed, err := rrule.SloCorrections.Parse(*rule)
+	if err != nil {
+		return nil, loc, err
+	}
+	var d time.Duration
+	if duration != nil {
+		d = time.Duration(*duration) * time.Second
+	}
+	return parsed.Windows(dtstart, d, after, n), loc, nil
+}
+
+func sloCorrectionWindowsBefore(windows []rrule.Window, t time.Time) []rrule.Window {
+	for i, w := range windows {
+		if !w.Start.Before(t) {
+			return windows[:i]
+		}
+	}
+	return windows
+}
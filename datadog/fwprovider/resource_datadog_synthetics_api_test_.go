@@ -0,0 +1,303 @@
+package fwprovider
+
+import (
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
+)
+
+type syntheticsAPITestModel struct {
+	syntheticsTestCommonModel
+	Subtype             types.String                    `tfsdk:"subtype"`
+	RequestDefinition   []syntheticsTestRequestModel    `tfsdk:"request_definition"`
+	RequestHeaders      map[string]types.String         `tfsdk:"request_headers"`
+	RequestQuery        map[string]types.String         `tfsdk:"request_query"`
+	Assertions          []syntheticsTestAssertionModel  `tfsdk:"assertion"`
+	VariablesFromScript types.String                    `tfsdk:"variables_from_script"`
+	OptionsList         []syntheticsAPITestOptionsModel `tfsdk:"options_list"`
+}
+
+func (m *syntheticsAPITestModel) subtype() string {
+	return m.Subtype.ValueString()
+}
+
+// syntheticsTestRequestModel holds the request of the API tests, and of the steps of the multistep API tests.
+type syntheticsTestRequestModel struct {
+	Method                 types.String `tfsdk:"method"`
+	URL                    types.String `tfsdk:"url"`
+	Body                   types.String `tfsdk:"body"`
+	BodyType               types.String `tfsdk:"body_type"`
+	Timeout                types.Int64  `tfsdk:"timeout"`
+	Host                   types.String `tfsdk:"host"`
+	Port                   types.String `tfsdk:"port"`
+	DNSServer              types.String `tfsdk:"dns_server"`
+	DNSServerPort          types.String `tfsdk:"dns_server_port"`
+	Servername             types.String `tfsdk:"servername"`
+	Message                types.String `tfsdk:"message"`
+	IsMessageBase64Encoded types.Bool   `tfsdk:"is_message_base64_encoded"`
+	CallType               types.String `tfsdk:"call_type"`
+	Service                types.String `tfsdk:"service"`
+	PlainProtoFile         types.String `tfsdk:"plain_proto_file"`
+	NumberOfPackets        types.Int64  `tfsdk:"number_of_packets"`
+	ShouldTrackHops        types.Bool   `tfsdk:"should_track_hops"`
+	NoSavingResponseBody   types.Bool   `tfsdk:"no_saving_response_body"`
+	PersistCookies         types.Bool   `tfsdk:"persist_cookies"`
+}
+
+type syntheticsTestAssertionModel struct {
+	Type         types.String `tfsdk:"type"`
+	Operator     types.String `tfsdk:"operator"`
+	Property     types.String `tfsdk:"property"`
+	Target       types.String `tfsdk:"target"`
+	TimingsScope types.String `tfsdk:"timings_scope"`
+}
+
+type syntheticsAPITestOptionsModel struct {
+	syntheticsTestOptionsModel
+	FollowRedirects            types.Bool   `tfsdk:"follow_redirects"`
+	AllowInsecure              types.Bool   `tfsdk:"allow_insecure"`
+	AcceptSelfSigned           types.Bool   `tfsdk:"accept_self_signed"`
+	CheckCertificateRevocation types.Bool   `tfsdk:"check_certificate_revocation"`
+	HTTPVersion                types.String `tfsdk:"http_version"`
+}
+
+func syntheticsTestRequestSchema() schema.NestedBlockObject {
+	return schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			"method": schema.StringAttribute{
+				Description: "Either the HTTP method/verb to use or a gRPC method available on the service set in the `service` field. Required if `subtype` is `http` or if `subtype` is `grpc` and `call_type` is `unary`.",
+				Optional:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "The URL to send the request to.",
+				Optional:    true,
+			},
+			"body": schema.StringAttribute{
+				Description: "The request body.",
+				Optional:    true,
+			},
+			"body_type": schema.StringAttribute{
+				Description: "Type of the request body.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.NewEnumValidator[validator.String](datadogV1.NewSyntheticsTestRequestBodyTypeFromValue),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				Description: "Timeout in seconds for the test.",
+				Optional:    true,
+			},
+			"host": schema.StringAttribute{
+				Description: "Host name to perform the test with.",
+				Optional:    true,
+			},
+			"port": schema.StringAttribute{
+				Description: "Port to use when performing the test.",
+				Optional:    true,
+			},
+			"dns_server": schema.StringAttribute{
+				Description: "DNS server to use for DNS tests (`subtype = \"dns\"`).",
+				Optional:    true,
+			},
+			"dns_server_port": schema.StringAttribute{
+				Description: "DNS server port to use for DNS tests.",
+				Optional:    true,
+			},
+			"servername": schema.StringAttribute{
+				Description: "For SSL tests, it specifies on which server you want to initiate the TLS handshake, allowing the server to present one of multiple possible certificates on the same IP address and TCP port number.",
+				Optional:    true,
+			},
+			"message": schema.StringAttribute{
+				Description: "For gRPC, UDP and websocket tests, message to send with the request.",
+				Optional:    true,
+			},
+			"is_message_base64_encoded": schema.BoolAttribute{
+				Description: "Whether the message is base64-encoded.",
+				Optional:    true,
+			},
+			"call_type": schema.StringAttribute{
+				Description: "The type of gRPC call to perform.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.NewEnumValidator[validator.String](datadogV1.NewSyntheticsTestCallTypeFromValue),
+				},
+			},
+			"service": schema.StringAttribute{
+				Description: "The gRPC service on which you want to perform the gRPC call.",
+				Optional:    true,
+			},
+			"plain_proto_file": schema.StringAttribute{
+				Description: "The content of a proto file as a string.",
+				Optional:    true,
+			},
+			"number_of_packets": schema.Int64Attribute{
+				Description: "Number of pings to use per test for ICMP tests (`subtype = \"icmp\"`) between 0 and 10.",
+				Optional:    true,
+			},
+			"should_track_hops": schema.BoolAttribute{
+				Description: "This will turn on a traceroute probe to discover all gateways along the path to the host destination. For ICMP tests (`subtype = \"icmp\"`).",
+				Optional:    true,
+			},
+			"no_saving_response_body": schema.BoolAttribute{
+				Description: "Determines whether or not to save the response body.",
+				Optional:    true,
+			},
+			"persist_cookies": schema.BoolAttribute{
+				Description: "Persist cookies across redirects.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func syntheticsTestAssertionSchema() schema.NestedBlockObject {
+	return schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "Type of assertion. **Note:** Only some combinations of `type` and `operator` are valid. Refer to `config.assertions` in the [Datadog API reference](https://docs.datadoghq.com/api/latest/synthetics/#create-an-api-test).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(syntheticsTestAssertionTypes()...),
+				},
+			},
+			"operator": schema.StringAttribute{
+				Description: "Assertion operator. **Note:** Only some combinations of `type` and `operator` are valid. Refer to `config.assertions` in the [Datadog API reference](https://docs.datadoghq.com/api/latest/synthetics/#create-an-api-test).",
+				Optional:    true,
+			},
+			"property": schema.StringAttribute{
+				Description: "If assertion type is `header`, this is the header name.",
+				Optional:    true,
+			},
+			"target": schema.StringAttribute{
+				Description: "Expected value. **Note:** Depends on the assertion type. Refer to `config.assertions` in the [Datadog API reference](https://docs.datadoghq.com/api/latest/synthetics/#create-an-api-test).",
+				Optional:    true,
+			},
+			"timings_scope": schema.StringAttribute{
+				Description: "Timings scope for response time assertions.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.NewEnumValidator[validator.String](datadogV1.NewSyntheticsAssertionTimingsScopeFromValue),
+				},
+			},
+		},
+	}
+}
+
+func syntheticsAPITestOptionsSchema() schema.NestedBlockObject {
+	object := syntheticsTestOptionsSchema("How often the test should run (in seconds). Valid range is `30-604800`.")
+	object.Attributes["follow_redirects"] = schema.BoolAttribute{
+		Description: "Determines whether or not the API HTTP test should follow redirects.",
+		Optional:    true,
+	}
+	object.Attributes["allow_insecure"] = schema.BoolAttribute{
+		Description: "Allows loading insecure content for an HTTP request.",
+		Optional:    true,
+	}
+	object.Attributes["accept_self_signed"] = schema.BoolAttribute{
+		Description: "For SSL tests, whether or not the test should allow self signed certificates.",
+		Optional:    true,
+	}
+	object.Attributes["check_certificate_revocation"] = schema.BoolAttribute{
+		Description: "For SSL tests, whether or not the test should fail on revoked certificate in stapled OCSP.",
+		Optional:    true,
+	}
+	object.Attributes["http_version"] = schema.StringAttribute{
+		Description: "HTTP version to use for an HTTP request.",
+		Optional:    true,
+		Computed:    true,
+		Default:     stringdefault.StaticString(string(datadogV1.SYNTHETICSTESTOPTIONSHTTPVERSION_ANY)),
+		Validators: []validator.String{
+			validators.NewEnumValidator[validator.String](datadogV1.NewSyntheticsTestOptionsHTTPVersionFromValue),
+		},
+	}
+	return object
+}
+
+var syntheticsAPITestKind = syntheticsTestKind{
+	typeName:    "synthetics_api_test",
+	description: "Provides a Datadog synthetics API test resource. This can be used to create and manage single-request Datadog synthetics API tests. Multistep API tests are managed with the `datadog_synthetics_multistep_api_test` resource. An existing `datadog_synthetics_test` resource can be moved to this resource with a `moved` block, unless it uses attributes which are only supported by the `datadog_synthetics_test` resource, such as authentication, proxies, client certificates, files, JSON path, JSON schema or XPath assertions, and advanced scheduling.",
+	testType:    datadogV1.SYNTHETICSTESTDETAILSTYPE_API,
+	attributes: map[string]schema.Attribute{
+		"locations": syntheticsTestLocationsAttribute(),
+		"subtype": schema.StringAttribute{
+			Description: "The subtype of the Synthetic API test.",
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString(string(datadogV1.SYNTHETICSTESTDETAILSSUBTYPE_HTTP)),
+			Validators: []validator.String{
+				stringvalidator.OneOf(syntheticsAPITestSubtypes()...),
+			},
+		},
+		"request_headers": schema.MapAttribute{
+			Description: "Header name and value map.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"request_query": schema.MapAttribute{
+			Description: "Query arguments name and value map.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"variables_from_script": schema.StringAttribute{
+			Description: "Variables defined from JavaScript code for HTTP tests.",
+			Optional:    true,
+		},
+	},
+	blocks: map[string]schema.Block{
+		"request_definition": schema.ListNestedBlock{
+			Description: "The synthetics test request.",
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: syntheticsTestRequestSchema(),
+		},
+		"assertion": schema.ListNestedBlock{
+			Description:  "Assertions used for the test. Multiple `assertion` blocks are allowed with the structure below.",
+			NestedObject: syntheticsTestAssertionSchema(),
+		},
+		"options_list": schema.ListNestedBlock{
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: syntheticsAPITestOptionsSchema(),
+		},
+	},
+	newModel: func() syntheticsTestModel {
+		return &syntheticsAPITestModel{}
+	},
+}
+
+// syntheticsTestAssertionTypes returns the types of the assertions with a target.
+func syntheticsTestAssertionTypes() []string {
+	var assertionTypes []string
+	for _, assertionType := range new(datadogV1.SyntheticsAssertionType).GetAllowedValues() {
+		assertionTypes = append(assertionTypes, string(assertionType))
+	}
+	for _, assertionType := range new(datadogV1.SyntheticsAssertionBodyHashType).GetAllowedValues() {
+		assertionTypes = append(assertionTypes, string(assertionType))
+	}
+	return assertionTypes
+}
+
+// syntheticsAPITestSubtypes returns the subtypes of the single-request API tests.
+func syntheticsAPITestSubtypes() []string {
+	var subtypes []string
+	for _, subtype := range new(datadogV1.SyntheticsTestDetailsSubType).GetAllowedValues() {
+		if subtype != datadogV1.SYNTHETICSTESTDETAILSSUBTYPE_MULTI {
+			subtypes = append(subtypes, string(subtype))
+		}
+	}
+	return subtypes
+}
+
+func NewSyntheticsAPITestResource() resource.Resource {
+	return newSyntheticsTestKindResource(syntheticsAPITestKind)
+}
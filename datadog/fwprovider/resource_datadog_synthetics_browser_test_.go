@@ -0,0 +1,434 @@
+package fwprovider
+
+import (
+	"regexp"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
+)
+
+type syntheticsBrowserTestModel struct {
+	syntheticsTestCommonModel
+	DeviceIDs         []types.String                       `tfsdk:"device_ids"`
+	RequestDefinition []syntheticsBrowserTestRequestModel  `tfsdk:"request_definition"`
+	RequestHeaders    map[string]types.String              `tfsdk:"request_headers"`
+	RequestQuery      map[string]types.String              `tfsdk:"request_query"`
+	SetCookie         types.String                         `tfsdk:"set_cookie"`
+	BrowserSteps      []syntheticsBrowserTestStepModel     `tfsdk:"browser_step"`
+	BrowserVariables  []syntheticsBrowserTestVariableModel `tfsdk:"browser_variable"`
+	OptionsList       []syntheticsBrowserTestOptionsModel  `tfsdk:"options_list"`
+}
+
+func (m *syntheticsBrowserTestModel) subtype() string {
+	return ""
+}
+
+type syntheticsBrowserTestRequestModel struct {
+	Method types.String `tfsdk:"method"`
+	URL    types.String `tfsdk:"url"`
+}
+
+type syntheticsBrowserTestStepModel struct {
+	Name          types.String                           `tfsdk:"name"`
+	Type          types.String                           `tfsdk:"type"`
+	AllowFailure  types.Bool                             `tfsdk:"allow_failure"`
+	AlwaysExecute types.Bool                             `tfsdk:"always_execute"`
+	ExitIfSucceed types.Bool                             `tfsdk:"exit_if_succeed"`
+	IsCritical    types.Bool                             `tfsdk:"is_critical"`
+	Timeout       types.Int64                            `tfsdk:"timeout"`
+	NoScreenshot  types.Bool                             `tfsdk:"no_screenshot"`
+	Params        []syntheticsBrowserTestStepParamsModel `tfsdk:"params"`
+}
+
+type syntheticsBrowserTestStepParamsModel struct {
+	Attribute          types.String                                   `tfsdk:"attribute"`
+	Check              types.String                                   `tfsdk:"check"`
+	ClickType          types.String                                   `tfsdk:"click_type"`
+	Code               types.String                                   `tfsdk:"code"`
+	Delay              types.Int64                                    `tfsdk:"delay"`
+	Element            types.String                                   `tfsdk:"element"`
+	ElementUserLocator []syntheticsBrowserTestElementUserLocatorModel `tfsdk:"element_user_locator"`
+	Modifiers          []types.String                                 `tfsdk:"modifiers"`
+	Request            types.String                                   `tfsdk:"request"`
+	SubtestPublicID    types.String                                   `tfsdk:"subtest_public_id"`
+	Value              types.String                                   `tfsdk:"value"`
+	Variable           []syntheticsBrowserTestStepVariableModel       `tfsdk:"variable"`
+	WithClick          types.Bool                                     `tfsdk:"with_click"`
+	X                  types.Int64                                    `tfsdk:"x"`
+	Y                  types.Int64                                    `tfsdk:"y"`
+}
+
+type syntheticsBrowserTestElementUserLocatorModel struct {
+	FailTestOnCannotLocate types.Bool                                          `tfsdk:"fail_test_on_cannot_locate"`
+	Value                  []syntheticsBrowserTestElementUserLocatorValueModel `tfsdk:"value"`
+}
+
+type syntheticsBrowserTestElementUserLocatorValueModel struct {
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+type syntheticsBrowserTestStepVariableModel struct {
+	Name    types.String `tfsdk:"name"`
+	Example types.String `tfsdk:"example"`
+	Secure  types.Bool   `tfsdk:"secure"`
+}
+
+type syntheticsBrowserTestVariableModel struct {
+	Type    types.String `tfsdk:"type"`
+	Name    types.String `tfsdk:"name"`
+	Example types.String `tfsdk:"example"`
+	Pattern types.String `tfsdk:"pattern"`
+	ID      types.String `tfsdk:"id"`
+	Secure  types.Bool   `tfsdk:"secure"`
+}
+
+type syntheticsBrowserTestOptionsModel struct {
+	syntheticsTestOptionsModel
+	NoScreenshot                 types.Bool  `tfsdk:"no_screenshot"`
+	DisableCsp                   types.Bool  `tfsdk:"disable_csp"`
+	DisableCors                  types.Bool  `tfsdk:"disable_cors"`
+	InitialNavigationTimeout     types.Int64 `tfsdk:"initial_navigation_timeout"`
+	IgnoreServerCertificateError types.Bool  `tfsdk:"ignore_server_certificate_error"`
+}
+
+func syntheticsBrowserTestStepSchema() schema.NestedBlockObject {
+	return schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of the step.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the step.",
+				Required:    true,
+				Validators: []validator.String{
+					validators.NewEnumValidator[validator.String](datadogV1.NewSyntheticsStepTypeFromValue),
+				},
+			},
+			"allow_failure": schema.BoolAttribute{
+				Description: "Determines if the step should be allowed to fail.",
+				Optional:    true,
+			},
+			"always_execute": schema.BoolAttribute{
+				Description: "Determines whether or not to always execute this step even if the previous step failed or was skipped.",
+				Optional:    true,
+			},
+			"exit_if_succeed": schema.BoolAttribute{
+				Description: "Determines whether or not to exit the test if the step succeeds.",
+				Optional:    true,
+			},
+			"is_critical": schema.BoolAttribute{
+				Description: "Determines whether or not to consider the entire test as failed if this step fails. Can be used only if `allow_failure` is `true`.",
+				Optional:    true,
+			},
+			"timeout": schema.Int64Attribute{
+				Description: "Used to override the default timeout of a step.",
+				Optional:    true,
+			},
+			"no_screenshot": schema.BoolAttribute{
+				Description: "Prevents saving screenshots of the step.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"params": schema.ListNestedBlock{
+				Description: "Parameters for the step.",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: syntheticsBrowserTestStepParamsSchema(),
+			},
+		},
+	}
+}
+
+func syntheticsBrowserTestStepParamsSchema() schema.NestedBlockObject {
+	return schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			"attribute": schema.StringAttribute{
+				Description: `Name of the attribute to use for an "assert attribute" step.`,
+				Optional:    true,
+			},
+			"check": schema.StringAttribute{
+				Description: "Check type to use for an assertion step.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.NewEnumValidator[validator.String](datadogV1.NewSyntheticsCheckTypeFromValue),
+				},
+			},
+			"click_type": schema.StringAttribute{
+				Description: `Type of click to use for a "click" step.`,
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("contextual", "double", "primary"),
+				},
+			},
+			"code": schema.StringAttribute{
+				Description: "Javascript code to use for the step.",
+				Optional:    true,
+			},
+			"delay": schema.Int64Attribute{
+				Description: `Delay between each key stroke for a "type test" step.`,
+				Optional:    true,
+			},
+			"element": schema.StringAttribute{
+				Description: "Element to use for the step, JSON encoded string. The backend updates the element of the steps, the configured value is kept in the state.",
+				Optional:    true,
+			},
+			"modifiers": schema.ListAttribute{
+				Description: `Modifier to use for a "press key" step.`,
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf("Alt", "Control", "Meta", "Shift")),
+				},
+			},
+			"request": schema.StringAttribute{
+				Description: "Request for an API step.",
+				Optional:    true,
+			},
+			"subtest_public_id": schema.StringAttribute{
+				Description: "ID of the Synthetics test to use as subtest. The subtest is checked at plan time: it must be a browser test, the variables it uses must be defined by it or by the tests playing it, and subtests can't be nested more than two levels deep nor play each other.",
+				Optional:    true,
+			},
+			"value": schema.StringAttribute{
+				Description: "Value of the step.",
+				Optional:    true,
+			},
+			"with_click": schema.BoolAttribute{
+				Description: `For "file upload" steps.`,
+				Optional:    true,
+			},
+			"x": schema.Int64Attribute{
+				Description: `X coordinates for a "scroll step".`,
+				Optional:    true,
+			},
+			"y": schema.Int64Attribute{
+				Description: `Y coordinates for a "scroll step".`,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"element_user_locator": schema.ListNestedBlock{
+				Description: "Custom user selector to use for the step.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"fail_test_on_cannot_locate": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"value": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Optional: true,
+										Computed: true,
+										Default:  stringdefault.StaticString("css"),
+										Validators: []validator.String{
+											stringvalidator.OneOf("css", "xpath"),
+										},
+									},
+									"value": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"variable": schema.ListNestedBlock{
+				Description: "Details of the variable to extract.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the extracted variable.",
+							Optional:    true,
+						},
+						"example": schema.StringAttribute{
+							Description: "Example of the extracted variable.",
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(""),
+						},
+						"secure": schema.BoolAttribute{
+							Description: "Whether the value of this variable will be obfuscated in test results.",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func syntheticsBrowserTestVariableSchema() schema.NestedBlockObject {
+	return schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "Type of browser test variable.",
+				Required:    true,
+				Validators: []validator.String{
+					validators.NewEnumValidator[validator.String](datadogV1.NewSyntheticsBrowserVariableTypeFromValue),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the variable.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Z][A-Z0-9_]+[A-Z0-9]$`), "must be all uppercase with underscores"),
+				},
+			},
+			"example": schema.StringAttribute{
+				Description: "Example for the variable.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"pattern": schema.StringAttribute{
+				Description: "Pattern of the variable.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"id": schema.StringAttribute{
+				Description: "ID of the global variable to use. This is actually only used (and required) in the case of using a variable of type `global`.",
+				Optional:    true,
+			},
+			"secure": schema.BoolAttribute{
+				Description: "Determines whether or not the browser test variable is obfuscated. Can only be used with a browser variable of type `text`.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func syntheticsBrowserTestOptionsSchema() schema.NestedBlockObject {
+	object := syntheticsTestOptionsSchema("How often the test should run (in seconds). Valid range is `60-604800`.")
+	object.Attributes["no_screenshot"] = schema.BoolAttribute{
+		Description: "Prevents saving screenshots of the steps.",
+		Optional:    true,
+	}
+	object.Attributes["disable_csp"] = schema.BoolAttribute{
+		Description: "Disable Content Security Policy.",
+		Optional:    true,
+	}
+	object.Attributes["disable_cors"] = schema.BoolAttribute{
+		Description: "Disable Cross-Origin Resource Sharing.",
+		Optional:    true,
+	}
+	object.Attributes["initial_navigation_timeout"] = schema.Int64Attribute{
+		Description: "Timeout before declaring the initial step as failed (in seconds).",
+		Optional:    true,
+	}
+	object.Attributes["ignore_server_certificate_error"] = schema.BoolAttribute{
+		Description: "Ignore server certificate error.",
+		Optional:    true,
+	}
+	return object
+}
+
+var syntheticsBrowserTestKind = syntheticsTestKind{
+	typeName:    "synthetics_browser_test",
+	description: "Provides a Datadog synthetics browser test resource. This can be used to create and manage Datadog synthetics browser tests. An existing `datadog_synthetics_test` resource can be moved to this resource with a `moved` block, unless it uses attributes which are only supported by the `datadog_synthetics_test` resource, such as authentication, proxies, client certificates, step files, emails, requests and patterns, RUM settings, and advanced scheduling.",
+	testType:    datadogV1.SYNTHETICSTESTDETAILSTYPE_BROWSER,
+	attributes: map[string]schema.Attribute{
+		"locations": syntheticsTestLocationsAttribute(),
+		"device_ids": schema.ListAttribute{
+			Description: "Array with the different device IDs used to run the test.",
+			Required:    true,
+			ElementType: types.StringType,
+		},
+		"request_headers": schema.MapAttribute{
+			Description: "Header name and value map.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"request_query": schema.MapAttribute{
+			Description: "Query arguments name and value map.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"set_cookie": schema.StringAttribute{
+			Description: "Cookies to be used for the request, using the [Set-Cookie](https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Set-Cookie) syntax.",
+			Optional:    true,
+		},
+	},
+	blocks: map[string]schema.Block{
+		"request_definition": schema.ListNestedBlock{
+			Description: "The request of the starting URL of the test.",
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"method": schema.StringAttribute{
+						Description: "The HTTP method of the request.",
+						Optional:    true,
+					},
+					"url": schema.StringAttribute{
+						Description: "The starting URL of the test.",
+						Required:    true,
+					},
+				},
+			},
+		},
+		"browser_step": schema.ListNestedBlock{
+			Description:  "Steps for browser tests.",
+			NestedObject: syntheticsBrowserTestStepSchema(),
+		},
+		"browser_variable": schema.ListNestedBlock{
+			Description:  "Variables used for the browser test steps. Multiple `browser_variable` blocks are allowed with the structure below.",
+			NestedObject: syntheticsBrowserTestVariableSchema(),
+		},
+		"options_list": schema.ListNestedBlock{
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: syntheticsBrowserTestOptionsSchema(),
+		},
+	},
+	newModel: func() syntheticsTestModel {
+		return &syntheticsBrowserTestModel{}
+	},
+	// The backend updates the element of the steps, so the element of the prior state is kept
+	refresh: func(prior, state syntheticsTestModel) {
+		priorSteps := prior.(*syntheticsBrowserTestModel).BrowserSteps
+		for i, step := range state.(*syntheticsBrowserTestModel).BrowserSteps {
+			if i < len(priorSteps) && len(priorSteps[i].Params) > 0 && len(step.Params) > 0 {
+				step.Params[0].Element = priorSteps[i].Params[0].Element
+			}
+		}
+	},
+}
+
+func NewSyntheticsBrowserTestResource() resource.Resource {
+	return newSyntheticsTestKindResource(syntheticsBrowserTestKind)
+}
@@ -3,6 +3,7 @@ package fwprovider
 import (
 	"context"
 	"regexp"
+	"time"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
@@ -32,6 +33,7 @@ var (
 type syntheticsGlobalVariableResource struct {
 	Api  *datadogV1.SyntheticsApi
 	Auth context.Context
+	Now  func() time.Time
 }
 
 type syntheticsGlobalVariableModel struct {
@@ -40,6 +42,9 @@ type syntheticsGlobalVariableModel struct {
 	Description      types.String `tfsdk:"description"`
 	Tags             types.List   `tfsdk:"tags"`
 	Value            types.String `tfsdk:"value"`
+	ValueWo          types.String `tfsdk:"value_wo"`
+	ValueWoVersion   types.Int64  `tfsdk:"value_wo_version"`
+	LastRotatedAt    types.String `tfsdk:"last_rotated_at"`
 	Secure           types.Bool   `tfsdk:"secure"`
 	ParseTestId      types.String `tfsdk:"parse_test_id"`
 	ParseTestOptions types.List   `tfsdk:"parse_test_options"`
@@ -105,6 +110,7 @@ func (r *syntheticsGlobalVariableResource) Configure(_ context.Context, request
 	providerData, _ := request.ProviderData.(*FrameworkProvider)
 	r.Api = providerData.DatadogApiInstances.GetSyntheticsApiV1()
 	r.Auth = providerData.Auth
+	r.Now = providerData.Now
 }
 
 func (r *syntheticsGlobalVariableResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -136,9 +142,32 @@ func (r *syntheticsGlobalVariableResource) Schema(_ context.Context, _ resource.
 				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
 			},
 			"value": schema.StringAttribute{
-				Description: "The value of the global variable. Required unless `is_fido` is set to `true`.",
+				Description: "The value of the global variable. Required unless `is_fido` is set to `true` or `value_wo` is set.",
 				Optional:    true,
 				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(frameworkPath.MatchRoot("value_wo")),
+				},
+			},
+			"value_wo": schema.StringAttribute{
+				Description: "Write-only value of the global variable, which is never stored in the state, for example a value read from an ephemeral resource. Requires Terraform 1.11 or later. Increment `value_wo_version` to update the value.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(frameworkPath.MatchRoot("value_wo_version")),
+				},
+			},
+			"value_wo_version": schema.Int64Attribute{
+				Description: "Version of the `value_wo` write-only value. Write-only values can't be compared with the state, increment the version to update the value of the global variable.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(frameworkPath.MatchRoot("value_wo")),
+				},
+			},
+			"last_rotated_at": schema.StringAttribute{
+				Description: "Time at which the value of the global variable was last set by Terraform, in RFC3339 format.",
+				Computed:    true,
 			},
 			"secure": schema.BoolAttribute{
 				Description: "If set to true, the value of the global variable is hidden. This setting is automatically set to `true` if `is_totp` or `is_fido` is set to `true`.",
@@ -290,6 +319,8 @@ func (r *syntheticsGlobalVariableResource) Read(ctx context.Context, request res
 func (r *syntheticsGlobalVariableResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	var state syntheticsGlobalVariableModel
 	response.Diagnostics.Append(request.Plan.Get(ctx, &state)...)
+	// Write-only values are only available in the configuration
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, frameworkPath.Root("value_wo"), &state.ValueWo)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
@@ -310,6 +341,8 @@ func (r *syntheticsGlobalVariableResource) Create(ctx context.Context, request r
 		return
 	}
 	r.updateState(ctx, &state, &resp)
+	state.ValueWo = types.StringNull()
+	state.LastRotatedAt = types.StringValue(r.Now().UTC().Format(time.RFC3339))
 
 	// Save data into Terraform state
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
@@ -318,6 +351,8 @@ func (r *syntheticsGlobalVariableResource) Create(ctx context.Context, request r
 func (r *syntheticsGlobalVariableResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	var state syntheticsGlobalVariableModel
 	response.Diagnostics.Append(request.Plan.Get(ctx, &state)...)
+	// Write-only values are only available in the configuration
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, frameworkPath.Root("value_wo"), &state.ValueWo)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
@@ -340,6 +375,11 @@ func (r *syntheticsGlobalVariableResource) Update(ctx context.Context, request r
 		return
 	}
 	r.updateState(ctx, &state, &resp)
+	state.ValueWo = types.StringNull()
+	// last_rotated_at is only unknown in the plan when the value changed
+	if state.LastRotatedAt.IsUnknown() {
+		state.LastRotatedAt = types.StringValue(r.Now().UTC().Format(time.RFC3339))
+	}
 
 	// Save data into Terraform state
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
@@ -376,9 +416,10 @@ func (r *syntheticsGlobalVariableResource) updateState(ctx context.Context, stat
 	state.IsFido = types.BoolValue(resp.GetIsFido())
 
 	if value, ok := resp.GetValueOk(); ok {
-		if !value.GetSecure() {
+		if !value.GetSecure() && state.ValueWoVersion.IsNull() {
 			// Only change the value in state if the global variable is not secure
 			// Otherwise it will not be returned by the api, so we keep the config value
+			// Write-only values are never saved in the state
 			state.Value = types.StringValue(value.GetValue())
 		}
 		if secure, ok := value.GetSecureOk(); ok {
@@ -501,10 +542,14 @@ func (r *syntheticsGlobalVariableResource) buildSyntheticsGlobalVariableRequestB
 		}
 	}
 
-	if !state.Value.IsNull() {
+	if !state.Value.IsNull() || !state.ValueWo.IsNull() {
 		var value datadogV1.SyntheticsGlobalVariableValue
 
-		value.SetValue(state.Value.ValueString())
+		if !state.ValueWo.IsNull() {
+			value.SetValue(state.ValueWo.ValueString())
+		} else {
+			value.SetValue(state.Value.ValueString())
+		}
 		if !state.Secure.IsNull() {
 			value.SetSecure(state.Secure.ValueBool())
 		}
@@ -551,21 +596,21 @@ func (r syntheticsGlobalVariableResource) ValidateConfig(ctx context.Context, re
 	isFido := config.IsFido.ValueBool()
 	isTotp := config.IsTotp.ValueBool()
 
-	// If `is_fido` is `true` and `value` is set, return an error.
-	if isFido && !config.Value.IsNull() {
+	// If `is_fido` is `true` and `value` or `value_wo` is set, return an error.
+	if isFido && (!config.Value.IsNull() || !config.ValueWo.IsNull()) {
 		resp.Diagnostics.AddAttributeError(
 			frameworkPath.Root("value"),
 			"Invalid Configuration",
-			"`value` cannot be set when `is_fido` is `true`.",
+			"`value` and `value_wo` cannot be set when `is_fido` is `true`.",
 		)
 	}
 
-	// If `is_fido` is `false` and `value` is not set, return an error.
-	if !isFido && config.Value.IsNull() {
+	// If `is_fido` is `false` and neither `value` nor `value_wo` is set, return an error.
+	if !isFido && config.Value.IsNull() && config.ValueWo.IsNull() {
 		resp.Diagnostics.AddAttributeError(
 			frameworkPath.Root("value"),
 			"Invalid Configuration",
-			"`value` must be set.",
+			"`value` or `value_wo` must be set.",
 		)
 	}
 
@@ -580,6 +625,9 @@ func (r syntheticsGlobalVariableResource) ValidateConfig(ctx context.Context, re
 }
 
 func (r syntheticsGlobalVariableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
 	var config syntheticsGlobalVariableModel
 	diags := req.Plan.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
@@ -587,6 +635,18 @@ func (r syntheticsGlobalVariableResource) ModifyPlan(ctx context.Context, req re
 		return
 	}
 
+	// Keep the rotation time unless a new value is set
+	if !req.State.Raw.IsNull() {
+		var state syntheticsGlobalVariableModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if config.Value.Equal(state.Value) && config.ValueWoVersion.Equal(state.ValueWoVersion) {
+			resp.Plan.SetAttribute(ctx, frameworkPath.Root("last_rotated_at"), state.LastRotatedAt)
+		}
+	}
+
 	isTotp := config.IsTotp.ValueBool()
 	isFido := config.IsFido.ValueBool()
 
@@ -0,0 +1,203 @@
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	frameworkPath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = &syntheticsMobileApplicationResource{}
+	_ resource.ResourceWithImportState = &syntheticsMobileApplicationResource{}
+)
+
+// The mobile applications API is not part of the API client yet, it is the one used by `datadog-ci` to upload applications.
+const syntheticsMobileApplicationsPath = "/api/unstable/synthetics/mobile/applications"
+
+type syntheticsMobileApplicationResource struct {
+	Api  *datadog.APIClient
+	Auth context.Context
+}
+
+type syntheticsMobileApplicationModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Platform    types.String `tfsdk:"platform"`
+	Description types.String `tfsdk:"description"`
+	Tags        types.Set    `tfsdk:"tags"`
+}
+
+type syntheticsMobileApplication struct {
+	ID          string   `json:"id,omitempty"`
+	Name        string   `json:"name"`
+	Platform    string   `json:"platform"`
+	Description string   `json:"description"`
+	Tags        []string `json:"tags"`
+}
+
+func NewSyntheticsMobileApplicationResource() resource.Resource {
+	return &syntheticsMobileApplicationResource{}
+}
+
+func (r *syntheticsMobileApplicationResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	providerData := request.ProviderData.(*FrameworkProvider)
+	r.Api = providerData.DatadogApiInstances.HttpClient
+	r.Auth = providerData.Auth
+}
+
+func (r *syntheticsMobileApplicationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "synthetics_mobile_application"
+}
+
+func (r *syntheticsMobileApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Provides a Datadog synthetics mobile application resource. This can be used to create and manage the applications exercised by synthetics mobile tests, their builds are uploaded with the `datadog_synthetics_mobile_application_version` resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": utils.ResourceIDAttribute(),
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the mobile application.",
+			},
+			"platform": schema.StringAttribute{
+				Required:      true,
+				Description:   "Platform of the mobile application.",
+				Validators:    []validator.String{stringvalidator.OneOf("android", "ios")},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "Description of the mobile application.",
+			},
+			"tags": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "A list of tags to associate with the mobile application.",
+			},
+		},
+	}
+}
+
+func (r *syntheticsMobileApplicationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, frameworkPath.Root("id"), request, response)
+}
+
+func (r *syntheticsMobileApplicationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state syntheticsMobileApplicationModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	respByte, httpResp, err := utils.SendRequest(r.Auth, r.Api, "GET", syntheticsMobileApplicationsPath+"/"+state.ID.ValueString(), nil)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.Append(utils.FrameworkErrorDiag(err, "error getting synthetics mobile application"))
+		return
+	}
+
+	response.Diagnostics.Append(r.updateState(ctx, &state, respByte)...)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *syntheticsMobileApplicationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var state syntheticsMobileApplicationModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	body := r.buildRequestBody(ctx, &state)
+	respByte, _, err := utils.SendRequest(r.Auth, r.Api, "POST", syntheticsMobileApplicationsPath, &body)
+	if err != nil {
+		response.Diagnostics.Append(utils.FrameworkErrorDiag(err, "error creating synthetics mobile application"))
+		return
+	}
+
+	response.Diagnostics.Append(r.updateState(ctx, &state, respByte)...)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *syntheticsMobileApplicationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var state syntheticsMobileApplicationModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	body := r.buildRequestBody(ctx, &state)
+	respByte, _, err := utils.SendRequest(r.Auth, r.Api, "PUT", syntheticsMobileApplicationsPath+"/"+state.ID.ValueString(), &body)
+	if err != nil {
+		response.Diagnostics.Append(utils.FrameworkErrorDiag(err, "error updating synthetics mobile application"))
+		return
+	}
+
+	response.Diagnostics.Append(r.updateState(ctx, &state, respByte)...)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *syntheticsMobileApplicationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state syntheticsMobileApplicationModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, httpResp, err := utils.SendRequest(r.Auth, r.Api, "DELETE", syntheticsMobileApplicationsPath+"/"+state.ID.ValueString(), nil)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		response.Diagnostics.Append(utils.FrameworkErrorDiag(err, "error deleting synthetics mobile application"))
+	}
+}
+
+func (r *syntheticsMobileApplicationResource) buildRequestBody(ctx context.Context, state *syntheticsMobileApplicationModel) syntheticsMobileApplication {
+	tags := []string{}
+	if !state.Tags.IsNull() {
+		state.Tags.ElementsAs(ctx, &tags, false)
+	}
+	return syntheticsMobileApplication{
+		Name:        state.Name.ValueString(),
+		Platform:    state.Platform.ValueString(),
+		Description: state.Description.ValueString(),
+		Tags:        tags,
+	}
+}
+
+func (r *syntheticsMobileApplicationResource) updateState(ctx context.Context, state *syntheticsMobileApplicationModel, respByte []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var application syntheticsMobileApplication
+	if err := json.Unmarshal(respByte, &application); err != nil {
+		diags.Append(utils.FrameworkErrorDiag(err, "error unmarshalling synthetics mobile application"))
+		return diags
+	}
+
+	state.ID = types.StringValue(application.ID)
+	state.Name = types.StringValue(application.Name)
+	state.Platform = types.StringValue(application.Platform)
+	state.Description = types.StringValue(application.Description)
+	if len(application.Tags) > 0 || !state.Tags.IsNull() {
+		var tagDiags diag.Diagnostics
+		state.Tags, tagDiags = types.SetValueFrom(ctx, types.StringType, application.Tags)
+		diags.Append(tagDiags...)
+	}
+	return diags
+}
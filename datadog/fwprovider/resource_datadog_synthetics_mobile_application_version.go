@@ -0,0 +1,436 @@
+package fwprovider
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	frameworkPath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = &syntheticsMobileApplicationVersionResource{}
+	_ resource.ResourceWithModifyPlan  = &syntheticsMobileApplicationVersionResource{}
+	_ resource.ResourceWithImportState = &syntheticsMobileApplicationVersionResource{}
+)
+
+const (
+	// Files are uploaded in parts of this size, except for the last one
+	syntheticsMobileApplicationPartSize = 10 * 1024 * 1024
+	// Uploaded files are validated by Datadog before a version is created
+	syntheticsMobileApplicationValidationTimeout = 5 * time.Minute
+)
+
+type syntheticsMobileApplicationVersionResource struct {
+	Api  *datadog.APIClient
+	Auth context.Context
+}
+
+type syntheticsMobileApplicationVersionModel struct {
+	ID            types.String `tfsdk:"id"`
+	ApplicationID types.String `tfsdk:"application_id"`
+	VersionName   types.String `tfsdk:"version_name"`
+	FilePath      types.String `tfsdk:"file_path"`
+	FileHash      types.String `tfsdk:"file_hash"`
+	FileName      types.String `tfsdk:"file_name"`
+	IsLatest      types.Bool   `tfsdk:"is_latest"`
+}
+
+type syntheticsMobileApplicationVersion struct {
+	ID            string `json:"id"`
+	ApplicationID string `json:"application_id"`
+	VersionName   string `json:"version_name"`
+	FileName      string `json:"file_name"`
+	IsLatest      bool   `json:"is_latest"`
+}
+
+type syntheticsMobileApplicationUploadPart struct {
+	PartNumber int    `json:"partNumber"`
+	Md5        string `json:"md5"`
+	BlockSize  int    `json:"blockSize"`
+}
+
+type syntheticsMobileApplicationPresignedURLs struct {
+	FileName string `json:"file_name"`
+	Params   struct {
+		Key      string            `json:"key"`
+		UploadID string            `json:"upload_id"`
+		URLs     map[string]string `json:"urls"`
+	} `json:"multipart_presigned_urls_params"`
+}
+
+type syntheticsMobileApplicationUploadedPart struct {
+	ETag       string `json:"ETag"`
+	PartNumber int    `json:"PartNumber"`
+}
+
+type syntheticsMobileApplicationUploadJob struct {
+	Status           string `json:"status"`
+	IsValid          bool   `json:"is_valid"`
+	InvalidAppResult *struct {
+		InvalidMessage string `json:"invalid_message"`
+	} `json:"invalid_app_result"`
+	ValidAppResult *struct {
+		AppVersionUUID string `json:"app_version_uuid"`
+	} `json:"valid_app_result"`
+}
+
+func NewSyntheticsMobileApplicationVersionResource() resource.Resource {
+	return &syntheticsMobileApplicationVersionResource{}
+}
+
+func (r *syntheticsMobileApplicationVersionResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	providerData := request.ProviderData.(*FrameworkProvider)
+	r.Api = providerData.DatadogApiInstances.HttpClient
+	r.Auth = providerData.Auth
+}
+
+func (r *syntheticsMobileApplicationVersionResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "synthetics_mobile_application_version"
+}
+
+func (r *syntheticsMobileApplicationVersionResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Provides a Datadog synthetics mobile application version resource. This can be used to upload a build of a mobile application, so that synthetics mobile tests can run on it.",
+		Attributes: map[string]schema.Attribute{
+			"id": utils.ResourceIDAttribute(),
+			"application_id": schema.StringAttribute{
+				Required:      true,
+				Description:   "ID of the mobile application.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"version_name": schema.StringAttribute{
+				Required:      true,
+				Description:   "Name of the version.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"file_path": schema.StringAttribute{
+				Required:    true,
+				Description: "Path of the `.apk` or `.ipa` file to upload. The file must exist when the version is created or when `file_path` changes. When it no longer exists, the uploaded version is kept as is.",
+			},
+			"file_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 hash of the content of the uploaded file. A new version is uploaded when the content of the file changes.",
+			},
+			"file_name": schema.StringAttribute{
+				Computed:      true,
+				Description:   "Name of the file stored by Datadog.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"is_latest": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether this version is the latest version of the application, which is used by mobile tests referencing the `latest` version.",
+			},
+		},
+	}
+}
+
+func (r *syntheticsMobileApplicationVersionResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var filePath types.String
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, frameworkPath.Root("file_path"), &filePath)...)
+	if response.Diagnostics.HasError() || filePath.IsUnknown() {
+		return
+	}
+
+	var state *syntheticsMobileApplicationVersionModel
+	if !request.State.Raw.IsNull() {
+		state = &syntheticsMobileApplicationVersionModel{}
+		response.Diagnostics.Append(request.State.Get(ctx, state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	fileHash, err := syntheticsMobileApplicationFileHash(filePath.ValueString())
+	if err != nil {
+		// The file is only needed to upload a new version, for example it may have been built on another machine
+		if errors.Is(err, fs.ErrNotExist) && state != nil && state.FilePath.Equal(filePath) {
+			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, frameworkPath.Root("file_hash"), state.FileHash)...)
+			return
+		}
+		response.Diagnostics.AddAttributeError(frameworkPath.Root("file_path"), "error reading mobile application file", err.Error())
+		return
+	}
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, frameworkPath.Root("file_hash"), fileHash)...)
+
+	// Imported versions have no known file hash, their file is assumed to be the uploaded one
+	if state != nil && !state.FileHash.IsNull() && state.FileHash.ValueString() != fileHash {
+		response.RequiresReplace = append(response.RequiresReplace, frameworkPath.Root("file_hash"))
+	}
+}
+
+func (r *syntheticsMobileApplicationVersionResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	result := strings.SplitN(request.ID, ":", 2)
+	if len(result) != 2 {
+		response.Diagnostics.AddError("error retrieving application_id or version id from given ID", `Expected format: "application_id:version_id"`)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, frameworkPath.Root("application_id"), result[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, frameworkPath.Root("id"), result[1])...)
+}
+
+func (r *syntheticsMobileApplicationVersionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state syntheticsMobileApplicationVersionModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	respByte, httpResp, err := utils.SendRequest(r.Auth, r.Api, "GET", syntheticsMobileApplicationVersionPath(state.ApplicationID.ValueString(), state.ID.ValueString()), nil)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.Append(utils.FrameworkErrorDiag(err, "error getting synthetics mobile application version"))
+		return
+	}
+
+	response.Diagnostics.Append(r.updateState(&state, respByte)...)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *syntheticsMobileApplicationVersionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var state syntheticsMobileApplicationVersionModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	file, err := os.Open(state.FilePath.ValueString())
+	if err != nil {
+		response.Diagnostics.AddAttributeError(frameworkPath.Root("file_path"), "error reading mobile application file", err.Error())
+		return
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		response.Diagnostics.AddAttributeError(frameworkPath.Root("file_path"), "error reading mobile application file", err.Error())
+		return
+	}
+
+	versionID, err := r.upload(ctx, &state, file, info.Size())
+	if err != nil {
+		response.Diagnostics.Append(utils.FrameworkErrorDiag(err, "error uploading synthetics mobile application version"))
+		return
+	}
+
+	respByte, _, err := utils.SendRequest(r.Auth, r.Api, "GET", syntheticsMobileApplicationVersionPath(state.ApplicationID.ValueString(), versionID), nil)
+	if err != nil {
+		response.Diagnostics.Append(utils.FrameworkErrorDiag(err, "error getting synthetics mobile application version"))
+		return
+	}
+
+	response.Diagnostics.Append(r.updateState(&state, respByte)...)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *syntheticsMobileApplicationVersionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var state syntheticsMobileApplicationVersionModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The content of the file can't change without replacing the version, only its latest flag is updated
+	body := map[string]bool{"is_latest": state.IsLatest.ValueBool()}
+	respByte, _, err := utils.SendRequest(r.Auth, r.Api, "PATCH", syntheticsMobileApplicationVersionPath(state.ApplicationID.ValueString(), state.ID.ValueString()), &body)
+	if err != nil {
+		response.Diagnostics.Append(utils.FrameworkErrorDiag(err, "error updating synthetics mobile application version"))
+		return
+	}
+
+	response.Diagnostics.Append(r.updateState(&state, respByte)...)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *syntheticsMobileApplicationVersionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state syntheticsMobileApplicationVersionModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, httpResp, err := utils.SendRequest(r.Auth, r.Api, "DELETE", syntheticsMobileApplicationVersionPath(state.ApplicationID.ValueString(), state.ID.ValueString()), nil)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		response.Diagnostics.Append(utils.FrameworkErrorDiag(err, "error deleting synthetics mobile application version"))
+	}
+}
+
+// upload sends the file in parts to the presigned URLs returned by Datadog, and waits for the validation of the
+// uploaded file. The parts are read from the file as they are sent, so that large files aren't held in memory. It
+// sets the hash of the file and returns the ID of the created version.
+func (r *syntheticsMobileApplicationVersionResource) upload(ctx context.Context, state *syntheticsMobileApplicationVersionModel, file io.ReaderAt, size int64) (string, error) {
+	applicationPath := syntheticsMobileApplicationsPath + "/" + state.ApplicationID.ValueString()
+
+	fileHash := sha256.New()
+	parts := make([]syntheticsMobileApplicationUploadPart, 0)
+	for offset := int64(0); offset < size || offset == 0; offset += syntheticsMobileApplicationPartSize {
+		partMd5 := md5.New()
+		part := io.NewSectionReader(file, offset, min(syntheticsMobileApplicationPartSize, size-offset))
+		if _, err := io.Copy(io.MultiWriter(partMd5, fileHash), part); err != nil {
+			return "", err
+		}
+		parts = append(parts, syntheticsMobileApplicationUploadPart{
+			PartNumber: len(parts) + 1,
+			Md5:        base64.StdEncoding.EncodeToString(partMd5.Sum(nil)),
+			BlockSize:  int(part.Size()),
+		})
+	}
+	state.FileHash = types.StringValue(hex.EncodeToString(fileHash.Sum(nil)))
+
+	presignBody := map[string]interface{}{"appSize": size, "parts": parts}
+	respByte, _, err := utils.SendRequest(r.Auth, r.Api, "POST", applicationPath+"/multipart-presigned-urls", &presignBody)
+	if err != nil {
+		return "", err
+	}
+	var presigned syntheticsMobileApplicationPresignedURLs
+	if err := json.Unmarshal(respByte, &presigned); err != nil {
+		return "", err
+	}
+
+	uploadedParts := make([]syntheticsMobileApplicationUploadedPart, 0, len(parts))
+	for i, part := range parts {
+		url, ok := presigned.Params.URLs[strconv.Itoa(part.PartNumber)]
+		if !ok {
+			return "", fmt.Errorf("no upload URL returned for part %d", part.PartNumber)
+		}
+		body := io.NewSectionReader(file, int64(i)*syntheticsMobileApplicationPartSize, int64(part.BlockSize))
+		etag, err := r.uploadPart(ctx, url, body, part.Md5)
+		if err != nil {
+			return "", fmt.Errorf("error uploading part %d: %s", part.PartNumber, err)
+		}
+		uploadedParts = append(uploadedParts, syntheticsMobileApplicationUploadedPart{ETag: etag, PartNumber: part.PartNumber})
+	}
+
+	completeBody := map[string]interface{}{
+		"uploadId": presigned.Params.UploadID,
+		"key":      presigned.Params.Key,
+		"parts":    uploadedParts,
+		"appUploadDetails": map[string]interface{}{
+			"versionName": state.VersionName.ValueString(),
+			"isLatest":    state.IsLatest.ValueBool(),
+		},
+	}
+	respByte, _, err = utils.SendRequest(r.Auth, r.Api, "POST", applicationPath+"/multipart-upload-complete", &completeBody)
+	if err != nil {
+		return "", err
+	}
+	var completed struct {
+		JobID string `json:"job_id"`
+	}
+	if err := json.Unmarshal(respByte, &completed); err != nil {
+		return "", err
+	}
+
+	var versionID string
+	err = retry.RetryContext(ctx, syntheticsMobileApplicationValidationTimeout, func() *retry.RetryError {
+		respByte, _, err := utils.SendRequest(r.Auth, r.Api, "GET", applicationPath+"/multipart-upload-complete/"+completed.JobID, nil)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		var job syntheticsMobileApplicationUploadJob
+		if err := json.Unmarshal(respByte, &job); err != nil {
+			return retry.NonRetryableError(err)
+		}
+		switch {
+		case job.Status != "complete":
+			return retry.RetryableError(fmt.Errorf("validation of the uploaded file is %s", job.Status))
+		case !job.IsValid && job.InvalidAppResult != nil:
+			return retry.NonRetryableError(fmt.Errorf("invalid mobile application file: %s", job.InvalidAppResult.InvalidMessage))
+		case !job.IsValid || job.ValidAppResult == nil:
+			return retry.NonRetryableError(fmt.Errorf("invalid mobile application file"))
+		}
+		versionID = job.ValidAppResult.AppVersionUUID
+		return nil
+	})
+	return versionID, err
+}
+
+// uploadPart sends a part of the file to its presigned URL, which must not receive the Datadog credentials.
+func (r *syntheticsMobileApplicationVersionResource) uploadPart(ctx context.Context, url string, body *io.SectionReader, chunkMd5 string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, body)
+	if err != nil {
+		return "", err
+	}
+	req.ContentLength = body.Size()
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Content-MD5", chunkMd5)
+	httpResp, err := r.Api.GetConfig().HTTPClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer httpResp.Body.Close()
+	if httpResp.StatusCode >= 300 {
+		body, _ := io.ReadAll(httpResp.Body)
+		return "", fmt.Errorf("%s: %s", httpResp.Status, body)
+	}
+	return httpResp.Header.Get("ETag"), nil
+}
+
+func (r *syntheticsMobileApplicationVersionResource) updateState(state *syntheticsMobileApplicationVersionModel, respByte []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var version syntheticsMobileApplicationVersion
+	if err := json.Unmarshal(respByte, &version); err != nil {
+		diags.Append(utils.FrameworkErrorDiag(err, "error unmarshalling synthetics mobile application version"))
+		return diags
+	}
+
+	state.ID = types.StringValue(version.ID)
+	state.ApplicationID = types.StringValue(version.ApplicationID)
+	state.VersionName = types.StringValue(version.VersionName)
+	state.FileName = types.StringValue(version.FileName)
+	state.IsLatest = types.BoolValue(version.IsLatest)
+	return diags
+}
+
+func syntheticsMobileApplicationVersionPath(applicationID string, versionID string) string {
+	return syntheticsMobileApplicationsPath + "/" + applicationID + "/versions/" + versionID
+}
+
+// syntheticsMobileApplicationFileHash returns the SHA-256 hash of the content of the file, read as a stream.
+func syntheticsMobileApplicationFileHash(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
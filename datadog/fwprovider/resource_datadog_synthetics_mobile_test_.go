@@ -0,0 +1,402 @@
+package fwprovider
+
+import (
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
+)
+
+type syntheticsMobileTestModel struct {
+	syntheticsTestCommonModel
+	ConfigInitialApplicationArguments map[string]types.String            `tfsdk:"config_initial_application_arguments"`
+	MobileOptionsList                 []syntheticsMobileTestOptionsModel `tfsdk:"mobile_options_list"`
+	MobileSteps                       []syntheticsMobileTestStepModel    `tfsdk:"mobile_step"`
+}
+
+func (m *syntheticsMobileTestModel) subtype() string {
+	return ""
+}
+
+type syntheticsMobileTestOptionsModel struct {
+	syntheticsTestMonitoringOptionsModel
+	DeviceIDs              []types.String                         `tfsdk:"device_ids"`
+	MobileApplication      []syntheticsMobileTestApplicationModel `tfsdk:"mobile_application"`
+	DefaultStepTimeout     types.Int64                            `tfsdk:"default_step_timeout"`
+	NoScreenshot           types.Bool                             `tfsdk:"no_screenshot"`
+	AllowApplicationCrash  types.Bool                             `tfsdk:"allow_application_crash"`
+	DisableAutoAcceptAlert types.Bool                             `tfsdk:"disable_auto_accept_alert"`
+	Verbosity              types.Int64                            `tfsdk:"verbosity"`
+}
+
+type syntheticsMobileTestApplicationModel struct {
+	ApplicationID types.String `tfsdk:"application_id"`
+	ReferenceID   types.String `tfsdk:"reference_id"`
+	ReferenceType types.String `tfsdk:"reference_type"`
+}
+
+type syntheticsMobileTestStepModel struct {
+	Name              types.String                          `tfsdk:"name"`
+	Type              types.String                          `tfsdk:"type"`
+	AllowFailure      types.Bool                            `tfsdk:"allow_failure"`
+	IsCritical        types.Bool                            `tfsdk:"is_critical"`
+	Timeout           types.Int64                           `tfsdk:"timeout"`
+	NoScreenshot      types.Bool                            `tfsdk:"no_screenshot"`
+	HasNewStepElement types.Bool                            `tfsdk:"has_new_step_element"`
+	Params            []syntheticsMobileTestStepParamsModel `tfsdk:"params"`
+}
+
+type syntheticsMobileTestStepParamsModel struct {
+	Check           types.String                            `tfsdk:"check"`
+	Delay           types.Int64                             `tfsdk:"delay"`
+	Direction       types.String                            `tfsdk:"direction"`
+	Enable          types.Bool                              `tfsdk:"enable"`
+	MaxScrolls      types.Int64                             `tfsdk:"max_scrolls"`
+	SubtestPublicID types.String                            `tfsdk:"subtest_public_id"`
+	Value           types.String                            `tfsdk:"value"`
+	WithEnter       types.Bool                              `tfsdk:"with_enter"`
+	X               types.Float64                           `tfsdk:"x"`
+	Y               types.Float64                           `tfsdk:"y"`
+	Variable        []syntheticsMobileTestStepVariableModel `tfsdk:"variable"`
+	Element         []syntheticsMobileTestStepElementModel  `tfsdk:"element"`
+}
+
+type syntheticsMobileTestStepVariableModel struct {
+	Name    types.String `tfsdk:"name"`
+	Example types.String `tfsdk:"example"`
+}
+
+type syntheticsMobileTestStepElementModel struct {
+	Context            types.String                               `tfsdk:"context"`
+	ContextType        types.String                               `tfsdk:"context_type"`
+	ElementDescription types.String                               `tfsdk:"element_description"`
+	TextContent        types.String                               `tfsdk:"text_content"`
+	ViewName           types.String                               `tfsdk:"view_name"`
+	MultiLocator       map[string]types.String                    `tfsdk:"multi_locator"`
+	UserLocator        []syntheticsMobileTestStepUserLocatorModel `tfsdk:"user_locator"`
+}
+
+type syntheticsMobileTestStepUserLocatorModel struct {
+	FailTestOnCannotLocate types.Bool                                      `tfsdk:"fail_test_on_cannot_locate"`
+	Values                 []syntheticsMobileTestStepUserLocatorValueModel `tfsdk:"values"`
+}
+
+type syntheticsMobileTestStepUserLocatorValueModel struct {
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+func syntheticsMobileTestOptionsSchema() schema.NestedBlockObject {
+	object := syntheticsTestMonitoringOptionsSchema("How often the test should run (in seconds). Valid range is `300-604800`.")
+	object.Attributes["tick_every"] = schema.Int64Attribute{
+		Description: "How often the test should run (in seconds). Valid range is `300-604800`.",
+		Required:    true,
+		Validators: []validator.Int64{
+			int64validator.Between(300, 604800),
+		},
+	}
+	object.Attributes["device_ids"] = schema.ListAttribute{
+		Description: "Array with the different device IDs used to run the test.",
+		Required:    true,
+		ElementType: types.StringType,
+	}
+	object.Attributes["default_step_timeout"] = schema.Int64Attribute{
+		Description: "Default timeout of the steps, in seconds.",
+		Optional:    true,
+		Validators: []validator.Int64{
+			int64validator.Between(1, 300),
+		},
+	}
+	object.Attributes["no_screenshot"] = schema.BoolAttribute{
+		Description: "Prevents saving screenshots of the steps.",
+		Optional:    true,
+	}
+	object.Attributes["allow_application_crash"] = schema.BoolAttribute{
+		Description: "Whether a crash of the application fails the test.",
+		Optional:    true,
+	}
+	object.Attributes["disable_auto_accept_alert"] = schema.BoolAttribute{
+		Description: "Disable the automatic acceptance of the alerts of the application.",
+		Optional:    true,
+	}
+	object.Attributes["verbosity"] = schema.Int64Attribute{
+		Description: "Verbosity of the test results.",
+		Optional:    true,
+		Validators: []validator.Int64{
+			int64validator.Between(0, 5),
+		},
+	}
+	object.Blocks["mobile_application"] = schema.ListNestedBlock{
+		Description: "The mobile application to test.",
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"application_id": schema.StringAttribute{
+					Description: "ID of the mobile application.",
+					Required:    true,
+				},
+				"reference_id": schema.StringAttribute{
+					Description: "ID of the version of the mobile application.",
+					Required:    true,
+				},
+				"reference_type": schema.StringAttribute{
+					Description: "Type of the version reference of the mobile application.",
+					Required:    true,
+					Validators: []validator.String{
+						validators.NewEnumValidator[validator.String](datadogV1.NewSyntheticsMobileTestsMobileApplicationReferenceTypeFromValue),
+					},
+				},
+			},
+		},
+	}
+	return object
+}
+
+func syntheticsMobileTestStepSchema() schema.NestedBlockObject {
+	return schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the step.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the step.",
+				Required:    true,
+				Validators: []validator.String{
+					validators.NewEnumValidator[validator.String](datadogV1.NewSyntheticsMobileStepTypeFromValue),
+				},
+			},
+			"allow_failure": schema.BoolAttribute{
+				Description: "A boolean set to allow this step to fail.",
+				Optional:    true,
+			},
+			"is_critical": schema.BoolAttribute{
+				Description: "A boolean to use in addition to `allow_failure` to determine if the test should be marked as failed when the step fails.",
+				Optional:    true,
+			},
+			"timeout": schema.Int64Attribute{
+				Description: "The time before declaring a step failed.",
+				Optional:    true,
+			},
+			"no_screenshot": schema.BoolAttribute{
+				Description: "A boolean set to not take a screenshot for the step.",
+				Optional:    true,
+			},
+			"has_new_step_element": schema.BoolAttribute{
+				Description: "A boolean set to determine if the step has a new step element.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"params": schema.ListNestedBlock{
+				Description: "Parameters for the step.",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: syntheticsMobileTestStepParamsSchema(),
+			},
+		},
+	}
+}
+
+func syntheticsMobileTestStepParamsSchema() schema.NestedBlockObject {
+	return schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			"check": schema.StringAttribute{
+				Description: "Check type to use for an assertion step.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.NewEnumValidator[validator.String](datadogV1.NewSyntheticsCheckTypeFromValue),
+				},
+			},
+			"delay": schema.Int64Attribute{
+				Description: `Delay between each key stroke for a "type test" step.`,
+				Optional:    true,
+			},
+			"direction": schema.StringAttribute{
+				Description: `Direction of a "scroll" step.`,
+				Optional:    true,
+				Validators: []validator.String{
+					validators.NewEnumValidator[validator.String](datadogV1.NewSyntheticsMobileStepParamsDirectionFromValue),
+				},
+			},
+			"enable": schema.BoolAttribute{
+				Description: "Whether to enable the setting of a toggle step.",
+				Optional:    true,
+			},
+			"max_scrolls": schema.Int64Attribute{
+				Description: `Maximum number of scrolls of a "scroll to element" step.`,
+				Optional:    true,
+			},
+			"subtest_public_id": schema.StringAttribute{
+				Description: "ID of the Synthetics test to use as subtest.",
+				Optional:    true,
+			},
+			"value": schema.StringAttribute{
+				Description: "Value of the step.",
+				Optional:    true,
+			},
+			"with_enter": schema.BoolAttribute{
+				Description: `Whether to press enter after a "type text" step.`,
+				Optional:    true,
+			},
+			"x": schema.Float64Attribute{
+				Description: `X coordinates for a "scroll step".`,
+				Optional:    true,
+			},
+			"y": schema.Float64Attribute{
+				Description: `Y coordinates for a "scroll step".`,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"variable": schema.ListNestedBlock{
+				Description: "Details of the variable to extract.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the extracted variable.",
+							Required:    true,
+						},
+						"example": schema.StringAttribute{
+							Description: "Example of the extracted variable.",
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(""),
+						},
+					},
+				},
+			},
+			"element": schema.ListNestedBlock{
+				Description: "Element to use for the step.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"context": schema.StringAttribute{
+							Description: "Context of the element.",
+							Optional:    true,
+						},
+						"context_type": schema.StringAttribute{
+							Description: "Type of the context of the element.",
+							Optional:    true,
+							Validators: []validator.String{
+								validators.NewEnumValidator[validator.String](datadogV1.NewSyntheticsMobileStepParamsElementContextTypeFromValue),
+							},
+						},
+						"element_description": schema.StringAttribute{
+							Description: "Description of the element.",
+							Optional:    true,
+						},
+						"text_content": schema.StringAttribute{
+							Description: "Text content of the element.",
+							Optional:    true,
+						},
+						"view_name": schema.StringAttribute{
+							Description: "Name of the view of the element.",
+							Optional:    true,
+						},
+						"multi_locator": schema.MapAttribute{
+							Description: "Locators of the element.",
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"user_locator": schema.ListNestedBlock{
+							Description: "Custom user selector of the element.",
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"fail_test_on_cannot_locate": schema.BoolAttribute{
+										Description: "Whether the test fails when the element can't be located.",
+										Optional:    true,
+										Computed:    true,
+										Default:     booldefault.StaticBool(false),
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"values": schema.ListNestedBlock{
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(5),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"type": schema.StringAttribute{
+													Description: "Type of the selector.",
+													Optional:    true,
+													Validators: []validator.String{
+														validators.NewEnumValidator[validator.String](datadogV1.NewSyntheticsMobileStepParamsElementUserLocatorValuesItemsTypeFromValue),
+													},
+												},
+												"value": schema.StringAttribute{
+													Description: "Value of the selector.",
+													Optional:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var syntheticsMobileTestKind = syntheticsTestKind{
+	typeName:    "synthetics_mobile_test",
+	description: "Provides a Datadog synthetics mobile test resource. This can be used to create and manage Datadog synthetics mobile application tests. An existing `datadog_synthetics_test` resource can be moved to this resource with a `moved` block, unless it uses attributes which are only supported by the `datadog_synthetics_test` resource, such as restriction policy bindings, CI options, element positions, and advanced scheduling.",
+	testType:    datadogV1.SYNTHETICSTESTDETAILSTYPE_MOBILE,
+	attributes: map[string]schema.Attribute{
+		"locations": schema.SetAttribute{
+			Description: "Array of locations used to run the test. Mobile tests run on the locations of their devices, so this value is kept in the state but isn't sent to the API.",
+			Required:    true,
+			ElementType: types.StringType,
+		},
+		"config_initial_application_arguments": schema.MapAttribute{
+			Description: "Initial application arguments for the mobile test.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+	},
+	blocks: map[string]schema.Block{
+		"mobile_options_list": schema.ListNestedBlock{
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: syntheticsMobileTestOptionsSchema(),
+		},
+		"mobile_step": schema.ListNestedBlock{
+			Description:  "Steps for mobile tests.",
+			NestedObject: syntheticsMobileTestStepSchema(),
+		},
+	},
+	newModel: func() syntheticsTestModel {
+		return &syntheticsMobileTestModel{}
+	},
+}
+
+func NewSyntheticsMobileTestResource() resource.Resource {
+	return newSyntheticsTestKindResource(syntheticsMobileTestKind)
+}
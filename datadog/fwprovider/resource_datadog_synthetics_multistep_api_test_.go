@@ -0,0 +1,224 @@
+package fwprovider
+
+import (
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
+)
+
+type syntheticsMultistepAPITestModel struct {
+	syntheticsTestCommonModel
+	APISteps    []syntheticsMultistepAPITestStepModel `tfsdk:"api_step"`
+	OptionsList []syntheticsTestOptionsModel          `tfsdk:"options_list"`
+}
+
+func (m *syntheticsMultistepAPITestModel) subtype() string {
+	return string(datadogV1.SYNTHETICSTESTDETAILSSUBTYPE_MULTI)
+}
+
+type syntheticsMultistepAPITestStepModel struct {
+	Name              types.String                             `tfsdk:"name"`
+	Subtype           types.String                             `tfsdk:"subtype"`
+	RequestDefinition []syntheticsMultistepAPITestRequestModel `tfsdk:"request_definition"`
+	RequestHeaders    map[string]types.String                  `tfsdk:"request_headers"`
+	RequestQuery      map[string]types.String                  `tfsdk:"request_query"`
+	Assertions        []syntheticsTestAssertionModel           `tfsdk:"assertion"`
+	ExtractedValues   []syntheticsTestExtractedValueModel      `tfsdk:"extracted_value"`
+	AllowFailure      types.Bool                               `tfsdk:"allow_failure"`
+	IsCritical        types.Bool                               `tfsdk:"is_critical"`
+	ExitIfSucceed     types.Bool                               `tfsdk:"exit_if_succeed"`
+	Retry             []syntheticsTestRetryModel               `tfsdk:"retry"`
+}
+
+// syntheticsMultistepAPITestRequestModel holds the request of a step, which also holds the request options of the
+// step.
+type syntheticsMultistepAPITestRequestModel struct {
+	syntheticsTestRequestModel
+	FollowRedirects types.Bool   `tfsdk:"follow_redirects"`
+	AllowInsecure   types.Bool   `tfsdk:"allow_insecure"`
+	HTTPVersion     types.String `tfsdk:"http_version"`
+}
+
+type syntheticsTestExtractedValueModel struct {
+	Name   types.String                              `tfsdk:"name"`
+	Type   types.String                              `tfsdk:"type"`
+	Field  types.String                              `tfsdk:"field"`
+	Parser []syntheticsTestExtractedValueParserModel `tfsdk:"parser"`
+	Secure types.Bool                                `tfsdk:"secure"`
+}
+
+type syntheticsTestExtractedValueParserModel struct {
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+func syntheticsMultistepAPITestRequestSchema() schema.NestedBlockObject {
+	object := syntheticsTestRequestSchema()
+	object.Attributes["follow_redirects"] = schema.BoolAttribute{
+		Description: "Determines whether or not the API HTTP test should follow redirects.",
+		Optional:    true,
+	}
+	object.Attributes["allow_insecure"] = schema.BoolAttribute{
+		Description: "Allows loading insecure content for a request in a multistep API test step.",
+		Optional:    true,
+	}
+	object.Attributes["http_version"] = schema.StringAttribute{
+		Description: "HTTP version to use for an HTTP request in a multistep API test step.",
+		Optional:    true,
+		Computed:    true,
+		Default:     stringdefault.StaticString(string(datadogV1.SYNTHETICSTESTOPTIONSHTTPVERSION_ANY)),
+		Validators: []validator.String{
+			validators.NewEnumValidator[validator.String](datadogV1.NewSyntheticsTestOptionsHTTPVersionFromValue),
+		},
+	}
+	return object
+}
+
+func syntheticsTestExtractedValueSchema() schema.NestedBlockObject {
+	return schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of the variable to save the value in.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Property of the Synthetics Test Response to use for the variable.",
+				Required:    true,
+				Validators: []validator.String{
+					validators.NewEnumValidator[validator.String](datadogV1.NewSyntheticsLocalVariableParsingOptionsTypeFromValue),
+				},
+			},
+			"field": schema.StringAttribute{
+				Description: "When type is `http_header` or `grpc_metadata`, name of the header or metadatum to extract.",
+				Optional:    true,
+			},
+			"secure": schema.BoolAttribute{
+				Description: "Determines whether or not the extracted value will be obfuscated.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"parser": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "Type of parser for a Synthetics global variable from a synthetics test.",
+							Required:    true,
+							Validators: []validator.String{
+								validators.NewEnumValidator[validator.String](datadogV1.NewSyntheticsGlobalVariableParserTypeFromValue),
+							},
+						},
+						"value": schema.StringAttribute{
+							Description: "Regex or JSON path used for the parser. Not used with type `raw`.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var syntheticsMultistepAPITestKind = syntheticsTestKind{
+	typeName:    "synthetics_multistep_api_test",
+	description: "Provides a Datadog synthetics multistep API test resource. This can be used to create and manage Datadog synthetics API tests chaining several requests. An existing `datadog_synthetics_test` resource can be moved to this resource with a `moved` block, unless it uses attributes which are only supported by the `datadog_synthetics_test` resource, such as wait steps, authentication, proxies, client certificates, files, JSON path, JSON schema or XPath assertions, and advanced scheduling.",
+	testType:    datadogV1.SYNTHETICSTESTDETAILSTYPE_API,
+	multistep:   true,
+	attributes: map[string]schema.Attribute{
+		"locations": syntheticsTestLocationsAttribute(),
+	},
+	blocks: map[string]schema.Block{
+		"api_step": schema.ListNestedBlock{
+			Description: "Steps for multistep API tests.",
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "The name of the step.",
+						Required:    true,
+					},
+					"subtype": schema.StringAttribute{
+						Description: "The subtype of the Synthetic multistep API test step.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString(string(datadogV1.SYNTHETICSAPITESTSTEPSUBTYPE_HTTP)),
+						Validators: []validator.String{
+							validators.NewEnumValidator[validator.String](datadogV1.NewSyntheticsAPITestStepSubtypeFromValue),
+						},
+					},
+					"request_headers": schema.MapAttribute{
+						Description: "Header name and value map.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"request_query": schema.MapAttribute{
+						Description: "Query arguments name and value map.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"allow_failure": schema.BoolAttribute{
+						Description: "Determines whether or not to continue with test if this step fails.",
+						Optional:    true,
+					},
+					"is_critical": schema.BoolAttribute{
+						Description: "Determines whether or not to consider the entire test as failed if this step fails. Can be used only if `allow_failure` is `true`.",
+						Optional:    true,
+					},
+					"exit_if_succeed": schema.BoolAttribute{
+						Description: "Determines whether or not to exit the test if the step succeeds.",
+						Optional:    true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"request_definition": schema.ListNestedBlock{
+						Description: "The request for the API step.",
+						Validators: []validator.List{
+							listvalidator.IsRequired(),
+							listvalidator.SizeAtMost(1),
+						},
+						NestedObject: syntheticsMultistepAPITestRequestSchema(),
+					},
+					"assertion": schema.ListNestedBlock{
+						Description:  "Assertions used for the step. Multiple `assertion` blocks are allowed with the structure below.",
+						NestedObject: syntheticsTestAssertionSchema(),
+					},
+					"extracted_value": schema.ListNestedBlock{
+						Description:  "Values to parse and save as variables from the response.",
+						NestedObject: syntheticsTestExtractedValueSchema(),
+					},
+					"retry": schema.ListNestedBlock{
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(1),
+						},
+						NestedObject: syntheticsTestRetrySchema(),
+					},
+				},
+			},
+		},
+		"options_list": schema.ListNestedBlock{
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: syntheticsTestOptionsSchema("How often the test should run (in seconds). Valid range is `30-604800`."),
+		},
+	},
+	newModel: func() syntheticsTestModel {
+		return &syntheticsMultistepAPITestModel{}
+	},
+}
+
+func NewSyntheticsMultistepAPITestResource() resource.Resource {
+	return newSyntheticsTestKindResource(syntheticsMultistepAPITestKind)
+}
@@ -1,9 +1,12 @@
 package fwprovider
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
@@ -18,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
 
 	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
 )
@@ -25,6 +29,7 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = &syntheticsPrivateLocationResource{}
 	_ resource.ResourceWithImportState = &syntheticsPrivateLocationResource{}
+	_ resource.ResourceWithModifyPlan  = &syntheticsPrivateLocationResource{}
 )
 
 type syntheticsPrivateLocationResource struct {
@@ -41,8 +46,21 @@ type syntheticsPrivateLocationModel struct {
 	Name                        types.String                             `tfsdk:"name"`
 	Tags                        types.List                               `tfsdk:"tags"`
 	ApiKey                      types.String                             `tfsdk:"api_key"`
+	KubernetesSecretManifest    types.String                             `tfsdk:"kubernetes_secret_manifest"`
+	HelmValues                  types.String                             `tfsdk:"helm_values"`
+	DockerCompose               types.String                             `tfsdk:"docker_compose"`
+	WorkerVersion               types.String                             `tfsdk:"worker_version"`
 }
 
+const (
+	// The private location worker reads its configuration from this file
+	syntheticsPrivateLocationConfigFile = "synthetics-check-runner.json"
+	syntheticsPrivateLocationConfigPath = "/etc/datadog/" + syntheticsPrivateLocationConfigFile
+	syntheticsPrivateLocationImage      = "datadog/synthetics-private-location-worker"
+	// Without a worker version, the version is read from this variable when starting the Docker Compose services
+	syntheticsPrivateLocationVersionVar = "SYNTHETICS_WORKER_VERSION"
+)
+
 type syntheticsPrivateLocationMetadataModel struct {
 	RestrictedRoles types.Set `tfsdk:"restricted_roles"`
 }
@@ -90,6 +108,34 @@ func (r *syntheticsPrivateLocationResource) Schema(_ context.Context, _ resource
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"kubernetes_secret_manifest": schema.StringAttribute{
+				Description: "Kubernetes Secret manifest, in YAML, holding the private location configuration under the `" + syntheticsPrivateLocationConfigFile + "` key. It can be referenced by the `configSecret` value of the `datadog/synthetics-private-location` Helm chart.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"helm_values": schema.StringAttribute{
+				Description: "Values file, in YAML, to install the private location worker with the `datadog/synthetics-private-location` Helm chart.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"worker_version": schema.StringAttribute{
+				Description: "Version of the `" + syntheticsPrivateLocationImage + "` image run by `docker_compose`, for example `1.50.0`. When not set, the version is read from the `" + syntheticsPrivateLocationVersionVar + "` environment variable when starting the services, so that upgrades of the worker are always explicit.",
+				Optional:    true,
+			},
+			"docker_compose": schema.StringAttribute{
+				Description: "Docker Compose file running the private location worker with its configuration, in the version set by `worker_version`.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"api_key": schema.StringAttribute{
 				Description: "API key used to generate the private location configuration.",
 				Optional:    true,
@@ -126,6 +172,22 @@ func (r *syntheticsPrivateLocationResource) ImportState(ctx context.Context, req
 	resource.ImportStatePassthroughID(ctx, frameworkPath.Root("id"), request, response)
 }
 
+func (r *syntheticsPrivateLocationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+	var plan, state syntheticsPrivateLocationModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	// The Docker Compose file is rendered again with the new worker version
+	if !plan.WorkerVersion.Equal(state.WorkerVersion) && !state.DockerCompose.IsNull() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, frameworkPath.Root("docker_compose"), types.StringUnknown())...)
+	}
+}
+
 func (r *syntheticsPrivateLocationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	var state syntheticsPrivateLocationModel
 	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
@@ -150,6 +212,7 @@ func (r *syntheticsPrivateLocationResource) Read(ctx context.Context, request re
 	}
 
 	r.updateState(ctx, &state, &resp)
+	response.Diagnostics.Append(r.updateArtifacts(&state)...)
 
 	// Save data into Terraform state
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
@@ -190,24 +253,20 @@ func (r *syntheticsPrivateLocationResource) Create(ctx context.Context, request
 
 	confStr := string(conf)
 	apiKey := state.ApiKey.ValueString()
-	fmt.Println("before if api key")
 	if apiKey != "" {
-		fmt.Println("api key is not empty")
 		// Remove the closing brace, append the new field, and add the brace back
 		if len(confStr) > 1 && confStr[len(confStr)-1] == '}' {
-			fmt.Println("confStr is not empty")
 			// If config is just "{}", avoid the comma
 			if confStr == "{}" {
 				confStr = fmt.Sprintf(`{"datadogApiKey":"%s"}`, apiKey)
-				fmt.Println("confStr is empty, new value is ", confStr)
 			} else {
 				confStr = confStr[:len(confStr)-1] + fmt.Sprintf(`,"datadogApiKey":"%s"}`, apiKey)
-				fmt.Println("confStr is not empty, new value is ", confStr)
 			}
 		}
 	}
 
 	state.Config = types.StringValue(confStr)
+	response.Diagnostics.Append(r.updateArtifacts(&state)...)
 
 	// Save data into Terraform state
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
@@ -311,3 +370,122 @@ func (r *syntheticsPrivateLocationResource) buildSyntheticsPrivateLocationReques
 
 	return syntheticsPrivateLocation, diags
 }
+
+// updateArtifacts renders the deployment artifacts of the private location worker from its configuration, which is
+// only known when the private location was created by Terraform.
+func (r *syntheticsPrivateLocationResource) updateArtifacts(state *syntheticsPrivateLocationModel) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	if state.Config.IsNull() || state.Config.IsUnknown() || state.Config.ValueString() == "" {
+		state.KubernetesSecretManifest = types.StringNull()
+		state.HelmValues = types.StringNull()
+		state.DockerCompose = types.StringNull()
+		return diags
+	}
+
+	// Indent the configuration so that it's rendered as a readable block in the YAML files
+	var config bytes.Buffer
+	if err := json.Indent(&config, []byte(state.Config.ValueString()), "", "  "); err != nil {
+		diags.AddError("error parsing private location config", err.Error())
+		return diags
+	}
+	configFile := config.String() + "\n"
+	name := syntheticsPrivateLocationResourceName(state.Id.ValueString())
+	version := state.WorkerVersion.ValueString()
+	if version == "" {
+		version = fmt.Sprintf("${%s:?set the version of the private location worker}", syntheticsPrivateLocationVersionVar)
+	}
+
+	artifacts := []struct {
+		value    *types.String
+		document interface{}
+	}{
+		{
+			value: &state.KubernetesSecretManifest,
+			document: syntheticsPrivateLocationSecret{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Metadata:   syntheticsPrivateLocationSecretMetadata{Name: name},
+				Type:       "Opaque",
+				StringData: map[string]string{syntheticsPrivateLocationConfigFile: configFile},
+			},
+		},
+		{
+			value:    &state.HelmValues,
+			document: syntheticsPrivateLocationHelmValues{ConfigFile: configFile},
+		},
+		{
+			value: &state.DockerCompose,
+			document: syntheticsPrivateLocationCompose{
+				Services: map[string]syntheticsPrivateLocationComposeService{
+					name: {
+						Image:   syntheticsPrivateLocationImage + ":" + version,
+						Restart: "unless-stopped",
+						Configs: []syntheticsPrivateLocationComposeServiceConfig{{Source: name, Target: syntheticsPrivateLocationConfigPath}},
+					},
+				},
+				Configs: map[string]syntheticsPrivateLocationComposeConfig{
+					name: {Content: configFile},
+				},
+			},
+		},
+	}
+	for _, artifact := range artifacts {
+		var document bytes.Buffer
+		encoder := yaml.NewEncoder(&document)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(artifact.document); err != nil {
+			diags.AddError("error rendering private location artifact", err.Error())
+			return diags
+		}
+		*artifact.value = types.StringValue(document.String())
+	}
+	return diags
+}
+
+var syntheticsPrivateLocationNameRegex = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// syntheticsPrivateLocationResourceName converts a private location ID, such as `pl:my-location-1a2b3c`, to a
+// name valid for Kubernetes and Docker Compose resources.
+func syntheticsPrivateLocationResourceName(id string) string {
+	name := "synthetics-" + strings.Trim(syntheticsPrivateLocationNameRegex.ReplaceAllString(strings.ToLower(id), "-"), "-")
+	if len(name) > 63 {
+		name = strings.TrimRight(name[:63], "-")
+	}
+	return name
+}
+
+type syntheticsPrivateLocationSecret struct {
+	APIVersion string                                  `yaml:"apiVersion"`
+	Kind       string                                  `yaml:"kind"`
+	Metadata   syntheticsPrivateLocationSecretMetadata `yaml:"metadata"`
+	Type       string                                  `yaml:"type"`
+	StringData map[string]string                       `yaml:"stringData"`
+}
+
+type syntheticsPrivateLocationSecretMetadata struct {
+	Name string `yaml:"name"`
+}
+
+type syntheticsPrivateLocationHelmValues struct {
+	ConfigFile string `yaml:"configFile"`
+}
+
+type syntheticsPrivateLocationCompose struct {
+	Services map[string]syntheticsPrivateLocationComposeService `yaml:"services"`
+	Configs  map[string]syntheticsPrivateLocationComposeConfig  `yaml:"configs"`
+}
+
+type syntheticsPrivateLocationComposeService struct {
+	Image   string                                          `yaml:"image"`
+	Restart string                                          `yaml:"restart"`
+	Configs []syntheticsPrivateLocationComposeServiceConfig `yaml:"configs"`
+}
+
+type syntheticsPrivateLocationComposeServiceConfig struct {
+	Source string `yaml:"source"`
+	Target string `yaml:"target"`
+}
+
+type syntheticsPrivateLocationComposeConfig struct {
+	Content string `yaml:"content"`
+}
@@ -0,0 +1,948 @@
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
+)
+
+var (
+	_ resource.ResourceWithConfigure      = &syntheticsTestKindResource{}
+	_ resource.ResourceWithImportState    = &syntheticsTestKindResource{}
+	_ resource.ResourceWithValidateConfig = &syntheticsTestKindResource{}
+	_ resource.ResourceWithModifyPlan     = &syntheticsTestKindResource{}
+	_ resource.ResourceWithMoveState      = &syntheticsTestKindResource{}
+)
+
+// syntheticsTestModel is implemented by the models of the typed synthetics test resources.
+type syntheticsTestModel interface {
+	common() *syntheticsTestCommonModel
+	// subtype returns the subtype of the test, or an empty string for the kinds of tests without subtypes.
+	subtype() string
+}
+
+// syntheticsTestCommonModel holds the attributes shared by all the kinds of synthetics tests.
+type syntheticsTestCommonModel struct {
+	ID                      types.String                        `tfsdk:"id"`
+	Name                    types.String                        `tfsdk:"name"`
+	Message                 types.String                        `tfsdk:"message"`
+	Tags                    []types.String                      `tfsdk:"tags"`
+	Status                  types.String                        `tfsdk:"status"`
+	Locations               types.Set                           `tfsdk:"locations"`
+	MonitorID               types.Int64                         `tfsdk:"monitor_id"`
+	ForceDeleteDependencies types.Bool                          `tfsdk:"force_delete_dependencies"`
+	ConfigVariables         []syntheticsTestConfigVariableModel `tfsdk:"config_variable"`
+	TriggerOnApply          []syntheticsTestTriggerOnApplyModel `tfsdk:"trigger_on_apply"`
+}
+
+func (m *syntheticsTestCommonModel) common() *syntheticsTestCommonModel {
+	return m
+}
+
+type syntheticsTestConfigVariableModel struct {
+	Type    types.String `tfsdk:"type"`
+	Name    types.String `tfsdk:"name"`
+	ID      types.String `tfsdk:"id"`
+	Example types.String `tfsdk:"example"`
+	Pattern types.String `tfsdk:"pattern"`
+	Secure  types.Bool   `tfsdk:"secure"`
+}
+
+type syntheticsTestTriggerOnApplyModel struct {
+	WaitForResult types.Bool   `tfsdk:"wait_for_result"`
+	Timeout       types.String `tfsdk:"timeout"`
+	FailOn        types.String `tfsdk:"fail_on"`
+}
+
+// syntheticsTestMonitoringOptionsModel holds the options shared by all the kinds of synthetics tests.
+type syntheticsTestMonitoringOptionsModel struct {
+	TickEvery          types.Int64                         `tfsdk:"tick_every"`
+	MinFailureDuration types.Int64                         `tfsdk:"min_failure_duration"`
+	MonitorName        types.String                        `tfsdk:"monitor_name"`
+	MonitorPriority    types.Int64                         `tfsdk:"monitor_priority"`
+	Retry              []syntheticsTestRetryModel          `tfsdk:"retry"`
+	MonitorOptions     []syntheticsTestMonitorOptionsModel `tfsdk:"monitor_options"`
+}
+
+// syntheticsTestOptionsModel holds the options shared by the API and browser tests.
+type syntheticsTestOptionsModel struct {
+	syntheticsTestMonitoringOptionsModel
+	MinLocationFailed types.Int64 `tfsdk:"min_location_failed"`
+}
+
+type syntheticsTestRetryModel struct {
+	Count    types.Int64 `tfsdk:"count"`
+	Interval types.Int64 `tfsdk:"interval"`
+}
+
+type syntheticsTestMonitorOptionsModel struct {
+	RenotifyInterval    types.Int64  `tfsdk:"renotify_interval"`
+	RenotifyOccurrences types.Int64  `tfsdk:"renotify_occurrences"`
+	EscalationMessage   types.String `tfsdk:"escalation_message"`
+}
+
+// syntheticsTestKind describes one of the typed synthetics test resources. They have their own schema and model,
+// and share the builders and CRUD functions of the `datadog_synthetics_test` resource for the API calls.
+type syntheticsTestKind struct {
+	typeName    string
+	description string
+	testType    datadogV1.SyntheticsTestDetailsType
+	// multistep tells the multistep API tests apart from the other API tests
+	multistep  bool
+	attributes map[string]schema.Attribute
+	blocks     map[string]schema.Block
+	newModel   func() syntheticsTestModel
+	// refresh optionally updates the state read from the API with values of the prior state
+	refresh func(prior, state syntheticsTestModel)
+}
+
+func (k syntheticsTestKind) schema() schema.Schema {
+	attributes := syntheticsTestCommonAttributes()
+	for name, attribute := range k.attributes {
+		attributes[name] = attribute
+	}
+	blocks := syntheticsTestCommonBlocks()
+	for name, block := range k.blocks {
+		blocks[name] = block
+	}
+	return schema.Schema{
+		Description: k.description,
+		Attributes:  attributes,
+		Blocks:      blocks,
+	}
+}
+
+func (k syntheticsTestKind) matches(testType, subtype string) bool {
+	if testType != string(k.testType) {
+		return false
+	}
+	return k.testType != datadogV1.SYNTHETICSTESTDETAILSTYPE_API || (subtype == string(datadogV1.SYNTHETICSTESTDETAILSSUBTYPE_MULTI)) == k.multistep
+}
+
+func (k syntheticsTestKind) mismatchDiag(id, testType, subtype string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"unexpected synthetics test kind",
+		fmt.Sprintf("synthetics test %s has type %q and subtype %q, it can't be managed with the `datadog_%s` resource", id, testType, subtype, k.typeName),
+	)
+}
+
+func syntheticsTestCommonAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": utils.ResourceIDAttribute(),
+		"name": schema.StringAttribute{
+			Description: "Name of Datadog synthetics test.",
+			Required:    true,
+		},
+		"message": schema.StringAttribute{
+			Description: "A message to include with notifications for this synthetics test. Email notifications can be sent to specific users by using the same `@username` notation as events.",
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString(""),
+		},
+		"tags": schema.ListAttribute{
+			Description: "A list of tags to associate with your synthetics test. This can help you categorize and filter tests in the manage synthetics page of the UI.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"status": schema.StringAttribute{
+			Description: "Define whether you want to start (`live`) or pause (`paused`) a Synthetic test.",
+			Required:    true,
+			Validators: []validator.String{
+				validators.NewEnumValidator[validator.String](datadogV1.NewSyntheticsTestPauseStatusFromValue),
+			},
+		},
+		"monitor_id": schema.Int64Attribute{
+			Description: "ID of the monitor associated with the Datadog synthetics test.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"force_delete_dependencies": schema.BoolAttribute{
+			Description: "A boolean indicating whether this synthetics test can be deleted even if it's referenced by other resources (for example, SLOs and composite monitors).",
+			Optional:    true,
+		},
+	}
+}
+
+func syntheticsTestCommonBlocks() map[string]schema.Block {
+	return map[string]schema.Block{
+		"config_variable": schema.ListNestedBlock{
+			Description: "Variables used for the test configuration. Multiple `config_variable` blocks are allowed with the structure below.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "Type of test configuration variable.",
+						Required:    true,
+						Validators: []validator.String{
+							validators.NewEnumValidator[validator.String](datadogV1.NewSyntheticsConfigVariableTypeFromValue),
+						},
+					},
+					"name": schema.StringAttribute{
+						Description: "Name of the variable.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Z][A-Z0-9_]+[A-Z0-9]$`), "must be all uppercase with underscores"),
+						},
+					},
+					"id": schema.StringAttribute{
+						Description: "When type = `global`, ID of the global variable to use.",
+						Optional:    true,
+					},
+					"example": schema.StringAttribute{
+						Description: "Example for the variable. This value is not returned by the API when `secure = true`. Avoid drift by only making updates to this value from within Terraform.",
+						Optional:    true,
+					},
+					"pattern": schema.StringAttribute{
+						Description: "Pattern of the variable. This value is not returned by the API when `secure = true`. Avoid drift by only making updates to this value from within Terraform.",
+						Optional:    true,
+					},
+					"secure": schema.BoolAttribute{
+						Description: "Whether the value of this variable will be obfuscated in test results.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+				},
+			},
+		},
+		"trigger_on_apply": schema.ListNestedBlock{
+			Description: "Trigger the test with the synthetics CI trigger endpoint after it's created or updated, and fail the apply if it fails. Failing results of a newly created test taint the resource.",
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"wait_for_result": schema.BoolAttribute{
+						Description: "Whether to wait for the results of the triggered test. When `false`, the test is triggered without checking its results.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(true),
+					},
+					"timeout": schema.StringAttribute{
+						Description: "How long to wait for the results of the triggered test, as a duration such as `30s` or `5m`.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("5m"),
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`), "must be a duration such as `30s` or `5m`"),
+						},
+					},
+					"fail_on": schema.StringAttribute{
+						Description: "Which failing results fail the apply. `blocking` only considers the results with a `blocking` CI execution rule, `any` considers all the failing results, and `none` reports the failing results as warnings.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("blocking"),
+						Validators: []validator.String{
+							stringvalidator.OneOf("blocking", "any", "none"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func syntheticsTestLocationsAttribute() schema.SetAttribute {
+	return schema.SetAttribute{
+		Description: "Array of locations used to run the test. Refer to [the Datadog Synthetics location data source](https://registry.terraform.io/providers/DataDog/datadog/latest/docs/data-sources/synthetics_locations) to retrieve the list of locations or find the possible values listed in [this API response](https://app.datadoghq.com/api/v1/synthetics/locations?only_public=true).",
+		Required:    true,
+		ElementType: types.StringType,
+	}
+}
+
+// syntheticsTestMonitoringOptionsSchema returns the schema of the options shared by all the kinds of synthetics tests.
+func syntheticsTestMonitoringOptionsSchema(tickEveryDescription string) schema.NestedBlockObject {
+	return schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			"tick_every": schema.Int64Attribute{
+				Description: tickEveryDescription,
+				Required:    true,
+			},
+			"min_failure_duration": schema.Int64Attribute{
+				Description: "Minimum amount of time in failure required to trigger an alert (in seconds).",
+				Optional:    true,
+			},
+			"monitor_name": schema.StringAttribute{
+				Description: "The monitor name is used for the alert title as well as for all monitor dashboard widgets and SLOs.",
+				Optional:    true,
+			},
+			"monitor_priority": schema.Int64Attribute{
+				Description: "The priority of the monitor associated with the test.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"retry": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: syntheticsTestRetrySchema(),
+			},
+			"monitor_options": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"renotify_interval": schema.Int64Attribute{
+							Description: "Specify a renotification frequency in minutes. Values available by default are `0`, `10`, `20`, `30`, `40`, `50`, `60`, `90`, `120`, `180`, `240`, `300`, `360`, `720`, `1440`.",
+							Optional:    true,
+							Computed:    true,
+							Default:     int64default.StaticInt64(0),
+						},
+						"renotify_occurrences": schema.Int64Attribute{
+							Description: "The number of times a monitor renotifies. It can only be set if `renotify_interval` is set.",
+							Optional:    true,
+						},
+						"escalation_message": schema.StringAttribute{
+							Description: "A message to include with a re-notification.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// syntheticsTestOptionsSchema returns the schema of the options shared by the API and browser tests.
+func syntheticsTestOptionsSchema(tickEveryDescription string) schema.NestedBlockObject {
+	object := syntheticsTestMonitoringOptionsSchema(tickEveryDescription)
+	object.Attributes["min_location_failed"] = schema.Int64Attribute{
+		Description: "Minimum number of locations in failure required to trigger an alert.",
+		Optional:    true,
+		Computed:    true,
+		Default:     int64default.StaticInt64(1),
+	}
+	return object
+}
+
+func syntheticsTestRetrySchema() schema.NestedBlockObject {
+	return schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			"count": schema.Int64Attribute{
+				Description: "Number of retries needed to consider a location as failed before sending a notification alert. Maximum value: `3` for `api` tests, `2` for `browser` and `mobile` tests.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+			},
+			"interval": schema.Int64Attribute{
+				Description: "Interval between a failed test and the next retry in milliseconds. Maximum value: `5000`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(300),
+			},
+		},
+	}
+}
+
+func newSyntheticsTestKindResource(kind syntheticsTestKind) resource.Resource {
+	return &syntheticsTestKindResource{
+		kind:        kind,
+		sdkResource: datadog.SyntheticsTestResource(),
+	}
+}
+
+type syntheticsTestKindResource struct {
+	kind        syntheticsTestKind
+	sdkResource *sdkschema.Resource
+	meta        *datadog.ProviderConfiguration
+}
+
+func (r *syntheticsTestKindResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	providerData := req.ProviderData.(*FrameworkProvider)
+	r.meta = &datadog.ProviderConfiguration{
+		CommunityClient:     providerData.CommunityClient,
+		DatadogApiInstances: providerData.DatadogApiInstances,
+		Auth:                providerData.Auth,
+		Now:                 providerData.Now,
+	}
+}
+
+func (r *syntheticsTestKindResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.kind.typeName
+}
+
+func (r *syntheticsTestKindResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = r.kind.schema()
+}
+
+func (r *syntheticsTestKindResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// Configurations with unknown values are validated again at apply time
+	if !req.Config.Raw.IsFullyKnown() {
+		return
+	}
+	config := r.kind.newModel()
+	resp.Diagnostics.Append(req.Config.Get(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d, err := r.data(config)
+	if err != nil {
+		resp.Diagnostics.AddError("error reading configuration", err.Error())
+		return
+	}
+	if err := datadog.ValidateSyntheticsTest(d); err != nil {
+		resp.Diagnostics.AddError(err.Error(), "")
+	}
+}
+
+func (r *syntheticsTestKindResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Subtests are resolved with the API, only available once the provider is configured
+	if r.meta == nil || r.kind.testType != datadogV1.SYNTHETICSTESTDETAILSTYPE_BROWSER || req.Plan.Raw.IsNull() {
+		return
+	}
+	// Unchanged tests are not resolved again, to avoid reading their subtests at every plan
+	if req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+	plan := r.kind.newModel()
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d, err := r.data(plan)
+	if err != nil {
+		resp.Diagnostics.AddError("error reading plan", err.Error())
+		return
+	}
+	if err := datadog.ValidateSyntheticsTestSubtests(d, r.meta); err != nil {
+		resp.Diagnostics.AddError(err.Error(), "")
+	}
+}
+
+func (r *syntheticsTestKindResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *syntheticsTestKindResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := r.kind.newModel()
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d, err := r.data(plan)
+	if err != nil {
+		resp.Diagnostics.AddError("error reading plan", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(syntheticsTestDiags(r.sdkResource.CreateContext(ctx, d, r.meta))...)
+	if d.Id() == "" {
+		return
+	}
+	r.setAppliedState(ctx, &resp.State, plan, d, &resp.Diagnostics)
+}
+
+func (r *syntheticsTestKindResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	prior := r.kind.newModel()
+	resp.Diagnostics.Append(req.State.Get(ctx, prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d, err := r.data(prior)
+	if err != nil {
+		resp.Diagnostics.AddError("error reading state", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(syntheticsTestDiags(r.sdkResource.ReadContext(ctx, d, r.meta))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if d.Id() == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if testType, subtype := d.Get("type").(string), d.Get("subtype").(string); !r.kind.matches(testType, subtype) {
+		resp.Diagnostics.Append(r.kind.mismatchDiag(d.Id(), testType, subtype))
+		return
+	}
+
+	// Imported resources only have an ID, all their attributes and blocks are read
+	priorValue := reflect.ValueOf(prior).Elem()
+	if prior.common().Name.IsNull() {
+		priorValue = reflect.Value{}
+	}
+	state := r.kind.newModel()
+	if err := syntheticsTestFromSDK(syntheticsTestSDKState(d, r.sdkResource), priorValue, reflect.ValueOf(state).Elem(), r.schemaObject()); err != nil {
+		resp.Diagnostics.AddError("error reading synthetics test", err.Error())
+		return
+	}
+	state.common().ID = types.StringValue(d.Id())
+	if r.kind.refresh != nil && priorValue.IsValid() {
+		r.kind.refresh(prior, state)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *syntheticsTestKindResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := r.kind.newModel()
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d, err := r.data(plan)
+	if err != nil {
+		resp.Diagnostics.AddError("error reading plan", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(syntheticsTestDiags(r.sdkResource.UpdateContext(ctx, d, r.meta))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.setAppliedState(ctx, &resp.State, plan, d, &resp.Diagnostics)
+}
+
+func (r *syntheticsTestKindResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := r.kind.newModel()
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d, err := r.data(state)
+	if err != nil {
+		resp.Diagnostics.AddError("error reading state", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(syntheticsTestDiags(r.sdkResource.DeleteContext(ctx, d, r.meta))...)
+}
+
+// setAppliedState saves the planned values, completed with the computed values set by the SDK functions.
+func (r *syntheticsTestKindResource) setAppliedState(ctx context.Context, state *tfsdk.State, plan syntheticsTestModel, d *sdkschema.ResourceData, diags *diag.Diagnostics) {
+	common := plan.common()
+	common.ID = types.StringValue(d.Id())
+	common.MonitorID = types.Int64Value(int64(d.Get("monitor_id").(int)))
+	diags.Append(state.Set(ctx, plan)...)
+}
+
+func (r *syntheticsTestKindResource) schemaObject() schema.NestedBlockObject {
+	s := r.kind.schema()
+	return schema.NestedBlockObject{Attributes: s.Attributes, Blocks: s.Blocks}
+}
+
+// data returns a SDK ResourceData holding the values of a model, to be used with the functions of the
+// `datadog_synthetics_test` resource. Null and unknown values are left unset.
+func (r *syntheticsTestKindResource) data(model syntheticsTestModel) (*sdkschema.ResourceData, error) {
+	d := r.sdkResource.Data(nil)
+	if id := model.common().ID; !id.IsNull() && !id.IsUnknown() {
+		d.SetId(id.ValueString())
+	}
+
+	values := syntheticsTestSDKValues(reflect.ValueOf(model).Elem())
+	values["type"] = string(r.kind.testType)
+	if subtype := model.subtype(); subtype != "" {
+		values["subtype"] = subtype
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return nil, fmt.Errorf("%s: %s", key, err)
+		}
+	}
+	return d, nil
+}
+
+func (r *syntheticsTestKindResource) MoveState(context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{StateMover: r.moveSyntheticsTestState},
+	}
+}
+
+// moveSyntheticsTestState moves a `datadog_synthetics_test` resource to the typed resource of its kind. Tests using
+// attributes which aren't supported by the typed resource can't be moved.
+func (r *syntheticsTestKindResource) moveSyntheticsTestState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if req.SourceTypeName != "datadog_synthetics_test" || req.SourceRawState == nil {
+		return
+	}
+
+	var values map[string]interface{}
+	if err := json.Unmarshal(req.SourceRawState.JSON, &values); err != nil {
+		resp.Diagnostics.AddError("error reading source state", err.Error())
+		return
+	}
+	id, _ := values["id"].(string)
+	testType, _ := values["type"].(string)
+	subtype, _ := values["subtype"].(string)
+	if !r.kind.matches(testType, subtype) {
+		resp.Diagnostics.Append(r.kind.mismatchDiag(id, testType, subtype))
+		return
+	}
+	state := r.kind.newModel()
+	if unsupported := syntheticsTestUnsupportedAttributes(r.sdkResource.SchemaMap(), values, reflect.TypeOf(state).Elem(), ""); len(unsupported) > 0 {
+		resp.Diagnostics.AddError(
+			"unsupported synthetics test attributes",
+			fmt.Sprintf("synthetics test %s sets attributes which are not supported by the `datadog_%s` resource: %s. They can only be managed by the `datadog_synthetics_test` resource: keep the test in it, or remove these attributes before moving it.", id, r.kind.typeName, strings.Join(unsupported, ", ")),
+		)
+		return
+	}
+	// API tests created before subtypes were introduced have no subtype
+	if r.kind.testType == datadogV1.SYNTHETICSTESTDETAILSTYPE_API && subtype == "" {
+		values["subtype"] = string(datadogV1.SYNTHETICSTESTDETAILSSUBTYPE_HTTP)
+	}
+
+	if err := syntheticsTestFromSDK(values, reflect.Value{}, reflect.ValueOf(state).Elem(), r.schemaObject()); err != nil {
+		resp.Diagnostics.AddError("error moving synthetics test state", err.Error())
+		return
+	}
+	state.common().ID = types.StringValue(id)
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, state)...)
+}
+
+// syntheticsTestDiags converts the diagnostics of the SDK functions to framework diagnostics.
+func syntheticsTestDiags(sdkDiags sdkdiag.Diagnostics) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, d := range sdkDiags {
+		if d.Severity == sdkdiag.Error {
+			diags.AddError(d.Summary, d.Detail)
+		} else {
+			diags.AddWarning(d.Summary, d.Detail)
+		}
+	}
+	return diags
+}
+
+// syntheticsTestSDKState returns the top-level values of a SDK ResourceData.
+func syntheticsTestSDKState(d *sdkschema.ResourceData, sdkResource *sdkschema.Resource) map[string]interface{} {
+	values := map[string]interface{}{}
+	for key := range sdkResource.SchemaMap() {
+		values[key] = d.Get(key)
+	}
+	return values
+}
+
+// syntheticsTestSDKValues converts a model to the values of the SDK resource, keyed by the tfsdk tags of the model.
+func syntheticsTestSDKValues(v reflect.Value) map[string]interface{} {
+	values := map[string]interface{}{}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if field.Anonymous {
+			for key, value := range syntheticsTestSDKValues(v.Field(i)) {
+				values[key] = value
+			}
+			continue
+		}
+		name := field.Tag.Get("tfsdk")
+		if name == "id" {
+			continue
+		}
+		if value, ok := syntheticsTestSDKValue(v.Field(i)); ok {
+			values[name] = value
+		}
+	}
+	return values
+}
+
+func syntheticsTestSDKValue(v reflect.Value) (interface{}, bool) {
+	if value, ok := v.Interface().(attr.Value); ok {
+		if value.IsNull() || value.IsUnknown() {
+			return nil, false
+		}
+		switch value := value.(type) {
+		case types.String:
+			return value.ValueString(), true
+		case types.Int64:
+			return int(value.ValueInt64()), true
+		case types.Float64:
+			return value.ValueFloat64(), true
+		case types.Bool:
+			return value.ValueBool(), true
+		case types.Set:
+			var elements []interface{}
+			for _, element := range value.Elements() {
+				elements = append(elements, element.(types.String).ValueString())
+			}
+			return elements, true
+		}
+		return nil, false
+	}
+
+	switch v.Kind() {
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil, false
+		}
+		elements := make([]interface{}, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			if _, ok := v.Index(i).Interface().(attr.Value); !ok {
+				elements = append(elements, syntheticsTestSDKValues(v.Index(i)))
+			} else if element, ok := syntheticsTestSDKValue(v.Index(i)); ok {
+				elements = append(elements, element)
+			}
+		}
+		return elements, true
+	case reflect.Map:
+		if v.IsNil() {
+			return nil, false
+		}
+		values := make(map[string]interface{}, v.Len())
+		for _, key := range v.MapKeys() {
+			if value, ok := syntheticsTestSDKValue(v.MapIndex(key)); ok {
+				values[key.String()] = value
+			}
+		}
+		return values, true
+	}
+	return nil, false
+}
+
+// syntheticsTestFromSDK sets the fields of a model from the values of the SDK resource. The API returns values for
+// the attributes and blocks which aren't configured, so zero values are read as null when the prior value is null
+// and the attribute isn't computed, and blocks missing from the prior value are left out. When there is no prior
+// value, for example on import, all the blocks are read.
+func syntheticsTestFromSDK(values map[string]interface{}, prior, target reflect.Value, object schema.NestedBlockObject) error {
+	for i := 0; i < target.NumField(); i++ {
+		field := target.Type().Field(i)
+		var priorField reflect.Value
+		if prior.IsValid() {
+			priorField = prior.Field(i)
+		}
+		if field.Anonymous {
+			if err := syntheticsTestFromSDK(values, priorField, target.Field(i), object); err != nil {
+				return err
+			}
+			continue
+		}
+
+		name := field.Tag.Get("tfsdk")
+		if block, ok := object.Blocks[name]; ok {
+			value, err := syntheticsTestBlockFromSDK(values[name], priorField, field.Type, block.(schema.ListNestedBlock).NestedObject)
+			if err != nil {
+				return fmt.Errorf("%s.%w", name, err)
+			}
+			target.Field(i).Set(value)
+		} else {
+			value, err := syntheticsTestAttributeFromSDK(values[name], priorField, field.Type, object.Attributes[name].IsComputed())
+			if err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+			target.Field(i).Set(value)
+		}
+	}
+	return nil
+}
+
+func syntheticsTestBlockFromSDK(value interface{}, prior reflect.Value, t reflect.Type, object schema.NestedBlockObject) (reflect.Value, error) {
+	elements := syntheticsTestSDKElements(value)
+	if len(elements) == 0 || (prior.IsValid() && prior.IsNil()) {
+		return reflect.Zero(t), nil
+	}
+	blocks := reflect.MakeSlice(t, len(elements), len(elements))
+	for i, element := range elements {
+		var priorElement reflect.Value
+		if prior.IsValid() && i < prior.Len() {
+			priorElement = prior.Index(i)
+		}
+		elementValues, _ := element.(map[string]interface{})
+		if err := syntheticsTestFromSDK(elementValues, priorElement, blocks.Index(i), object); err != nil {
+			return reflect.Value{}, fmt.Errorf("%d.%w", i, err)
+		}
+	}
+	return blocks, nil
+}
+
+func syntheticsTestAttributeFromSDK(value interface{}, prior reflect.Value, t reflect.Type, computed bool) (reflect.Value, error) {
+	priorNull := !prior.IsValid()
+	if !priorNull {
+		if priorValue, ok := prior.Interface().(attr.Value); ok {
+			priorNull = priorValue.IsNull()
+		} else {
+			priorNull = prior.IsNil()
+		}
+	}
+	zeroAsNull := priorNull && !computed
+
+	switch t {
+	case reflect.TypeOf(types.String{}):
+		s, _ := value.(string)
+		if s == "" && zeroAsNull {
+			return reflect.ValueOf(types.StringNull()), nil
+		}
+		return reflect.ValueOf(types.StringValue(s)), nil
+	case reflect.TypeOf(types.Int64{}):
+		n := syntheticsTestSDKNumber(value)
+		if n == 0 && zeroAsNull {
+			return reflect.ValueOf(types.Int64Null()), nil
+		}
+		return reflect.ValueOf(types.Int64Value(int64(n))), nil
+	case reflect.TypeOf(types.Float64{}):
+		n := syntheticsTestSDKNumber(value)
+		if n == 0 && zeroAsNull {
+			return reflect.ValueOf(types.Float64Null()), nil
+		}
+		return reflect.ValueOf(types.Float64Value(n)), nil
+	case reflect.TypeOf(types.Bool{}):
+		b, _ := value.(bool)
+		if !b && zeroAsNull {
+			return reflect.ValueOf(types.BoolNull()), nil
+		}
+		return reflect.ValueOf(types.BoolValue(b)), nil
+	case reflect.TypeOf(types.Set{}):
+		elements := syntheticsTestSDKElements(value)
+		if len(elements) == 0 && zeroAsNull {
+			return reflect.ValueOf(types.SetNull(types.StringType)), nil
+		}
+		values := make([]attr.Value, 0, len(elements))
+		for _, element := range elements {
+			values = append(values, types.StringValue(fmt.Sprint(element)))
+		}
+		return reflect.ValueOf(types.SetValueMust(types.StringType, values)), nil
+	}
+
+	switch t.Kind() {
+	case reflect.Slice:
+		elements := syntheticsTestSDKElements(value)
+		if len(elements) == 0 && priorNull {
+			return reflect.Zero(t), nil
+		}
+		values := reflect.MakeSlice(t, 0, len(elements))
+		for _, element := range elements {
+			values = reflect.Append(values, reflect.ValueOf(types.StringValue(fmt.Sprint(element))))
+		}
+		return values, nil
+	case reflect.Map:
+		entries, _ := value.(map[string]interface{})
+		if len(entries) == 0 && priorNull {
+			return reflect.Zero(t), nil
+		}
+		values := reflect.MakeMapWithSize(t, len(entries))
+		for key, entry := range entries {
+			values.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(types.StringValue(fmt.Sprint(entry))))
+		}
+		return values, nil
+	}
+	return reflect.Value{}, fmt.Errorf("unsupported synthetics test model type %s", t)
+}
+
+// syntheticsTestSDKElements returns the elements of the lists and sets of the SDK resource. Sets are lists in the
+// JSON state of the resource.
+func syntheticsTestSDKElements(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		return v
+	case *sdkschema.Set:
+		return v.List()
+	}
+	return nil
+}
+
+// syntheticsTestSDKNumber returns the value of the numbers of the SDK resource, which are float64 in the JSON state
+// of the resource.
+func syntheticsTestSDKNumber(value interface{}) float64 {
+	switch v := value.(type) {
+	case int:
+		return float64(v)
+	case float64:
+		return v
+	}
+	return 0
+}
+
+// syntheticsTestUnsupportedAttributes lists the attributes set in the state of a `datadog_synthetics_test` resource
+// which have no counterpart in a model. Attributes holding their zero or default value are ignored.
+func syntheticsTestUnsupportedAttributes(sdkSchema map[string]*sdkschema.Schema, values map[string]interface{}, t reflect.Type, prefix string) []string {
+	fields := map[string]reflect.Type{}
+	syntheticsTestModelFields(t, fields)
+
+	keys := make([]string, 0, len(sdkSchema))
+	for key := range sdkSchema {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var unsupported []string
+	for _, key := range keys {
+		s := sdkSchema[key]
+		// The type and subtype are checked with the kind of the test, and computed attributes and the identifiers of
+		// the steps aren't configured
+		if prefix == "" && (key == "type" || key == "subtype") || s.Computed && !s.Optional || key == "public_id" {
+			continue
+		}
+		fieldType, ok := fields[key]
+		if !ok {
+			if !syntheticsTestIsDefault(s, values[key]) {
+				unsupported = append(unsupported, prefix+key)
+			}
+			continue
+		}
+		if nested, ok := s.Elem.(*sdkschema.Resource); ok {
+			for i, element := range syntheticsTestSDKElements(values[key]) {
+				elementValues, _ := element.(map[string]interface{})
+				unsupported = append(unsupported, syntheticsTestUnsupportedAttributes(nested.SchemaMap(), elementValues, fieldType.Elem(), fmt.Sprintf("%s%s.%d.", prefix, key, i))...)
+			}
+		}
+	}
+	return unsupported
+}
+
+func syntheticsTestModelFields(t reflect.Type, fields map[string]reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous {
+			syntheticsTestModelFields(field.Type, fields)
+			continue
+		}
+		fields[field.Tag.Get("tfsdk")] = field.Type
+	}
+}
+
+func syntheticsTestIsDefault(s *sdkschema.Schema, value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == "" || s.Default != nil && v == fmt.Sprint(s.Default)
+	case bool:
+		return !v || v == s.Default
+	case float64:
+		return v == 0 || s.Default != nil && v == syntheticsTestSDKNumber(s.Default)
+	case []interface{}:
+		// Blocks returned by the API with their default values, such as disabled RUM settings, aren't configured
+		nested, ok := s.Elem.(*sdkschema.Resource)
+		for _, element := range v {
+			elementValues, isBlock := element.(map[string]interface{})
+			if !ok || !isBlock {
+				return false
+			}
+			for key, elementSchema := range nested.SchemaMap() {
+				if !syntheticsTestIsDefault(elementSchema, elementValues[key]) {
+					return false
+				}
+			}
+		}
+		return true
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
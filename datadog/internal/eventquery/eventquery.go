@@ -0,0 +1,346 @@
+// Package eventquery parses the event queries used by On-Call routing rules, and evaluates them locally against
+// sample alerts so that routing can be tested without paging anybody.
+package eventquery
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Event is the part of an alert that queries can match on.
+type Event struct {
+	// Tags of the alert, in `key:value` form.
+	Tags []string
+	// Priority of the alert, from `P1` to `P5`.
+	Priority string
+}
+
+// Query is a parsed event query. The empty query matches every event.
+type Query struct {
+	root node
+}
+
+// Matches reports whether the event matches the query.
+func (q *Query) Matches(event Event) bool {
+	return q.root == nil || q.root.matches(event)
+}
+
+type node interface {
+	matches(Event) bool
+}
+
+type andNode []node
+
+func (n andNode) matches(event Event) bool {
+	for _, child := range n {
+		if !child.matches(event) {
+			return false
+		}
+	}
+	return true
+}
+
+type orNode []node
+
+func (n orNode) matches(event Event) bool {
+	for _, child := range n {
+		if child.matches(event) {
+			return true
+		}
+	}
+	return false
+}
+
+type notNode struct {
+	node
+}
+
+func (n notNode) matches(event Event) bool {
+	return !n.node.matches(event)
+}
+
+// termNode matches an attribute against a value, in which `*` matches any sequence of characters unless quoted.
+type termNode struct {
+	attribute string
+	tagKey    string
+	value     *regexp.Regexp
+}
+
+func (n termNode) matches(event Event) bool {
+	switch n.attribute {
+	case "priority":
+		return n.value.MatchString(event.Priority)
+	case "tags":
+		for _, tag := range event.Tags {
+			if n.value.MatchString(tag) {
+				return true
+			}
+		}
+	default:
+		for _, tag := range event.Tags {
+			key, value, _ := strings.Cut(tag, ":")
+			if strings.EqualFold(key, n.tagKey) && n.value.MatchString(value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Parse parses an event query. Terms are `attribute:value` pairs where the attribute is `priority`, `tags` or
+// `tags.<key>`. They can be combined with `AND` (the default between terms), `OR`, `NOT` or `-`, and grouped with
+// parentheses, including as `attribute:(value OR value)`.
+func Parse(query string) (*Query, error) {
+	tokens, err := tokenize(query)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	if len(tokens) == 0 {
+		return &Query{}, nil
+	}
+	root, err := p.parseOr("")
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t != nil {
+		return nil, fmt.Errorf("unexpected `%s`", t)
+	}
+	return &Query{root: root}, nil
+}
+
+type tokenKind int
+
+const (
+	wordToken tokenKind = iota
+	openToken
+	closeToken
+)
+
+type token struct {
+	kind tokenKind
+	// text is the word without its quotes.
+	text string
+	// colon is the index of the colon separating the attribute from the value in text, or -1.
+	colon int
+	// quoted is set when the value of the word is quoted.
+	quoted bool
+}
+
+func (t *token) String() string {
+	switch t.kind {
+	case openToken:
+		return "("
+	case closeToken:
+		return ")"
+	}
+	return t.text
+}
+
+func (t *token) isKeyword(keyword string) bool {
+	return t.kind == wordToken && !t.quoted && t.colon < 0 && t.text == keyword
+}
+
+func tokenize(query string) ([]*token, error) {
+	var tokens []*token
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		switch r := runes[i]; {
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			i++
+		case r == '(':
+			tokens = append(tokens, &token{kind: openToken, colon: -1})
+			i++
+		case r == ')':
+			tokens = append(tokens, &token{kind: closeToken, colon: -1})
+			i++
+		default:
+			t := &token{kind: wordToken, colon: -1}
+			var text strings.Builder
+			inQuote := false
+			for ; i < len(runes); i++ {
+				r := runes[i]
+				if r == '"' {
+					inQuote = !inQuote
+					t.quoted = true
+					continue
+				}
+				if inQuote && r == '\\' && i+1 < len(runes) {
+					i++
+					text.WriteRune(runes[i])
+					continue
+				}
+				if !inQuote && (r == ' ' || r == '\t' || r == '\n' || r == '\r' || r == '(' || r == ')') {
+					break
+				}
+				if !inQuote && r == ':' && t.colon < 0 && !t.quoted {
+					t.colon = text.Len()
+				}
+				text.WriteRune(r)
+			}
+			if inQuote {
+				return nil, fmt.Errorf("unterminated quote in `%s`", string(runes))
+			}
+			t.text = text.String()
+			tokens = append(tokens, t)
+		}
+	}
+	return tokens, nil
+}
+
+type parser struct {
+	tokens []*token
+	pos    int
+}
+
+func (p *parser) peek() *token {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return nil
+}
+
+func (p *parser) next() *token {
+	t := p.peek()
+	if t != nil {
+		p.pos++
+	}
+	return t
+}
+
+// parseOr parses terms joined with OR. Inside `attribute:(...)` groups, bare words are values of the attribute.
+func (p *parser) parseOr(attribute string) (node, error) {
+	var children orNode
+	for {
+		child, err := p.parseAnd(attribute)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+		if t := p.peek(); t == nil || !t.isKeyword("OR") {
+			break
+		}
+		p.next()
+	}
+	if len(children) == 1 {
+		return children[0], nil
+	}
+	return children, nil
+}
+
+func (p *parser) parseAnd(attribute string) (node, error) {
+	var children andNode
+	for {
+		child, err := p.parseUnary(attribute)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+
+		t := p.peek()
+		if t != nil && t.isKeyword("AND") {
+			p.next()
+			continue
+		}
+		if t == nil || t.kind == closeToken || t.isKeyword("OR") {
+			break
+		}
+	}
+	if len(children) == 1 {
+		return children[0], nil
+	}
+	return children, nil
+}
+
+func (p *parser) parseUnary(attribute string) (node, error) {
+	t := p.peek()
+	if t == nil {
+		return nil, fmt.Errorf("unexpected end of query")
+	}
+	if t.isKeyword("NOT") || t.isKeyword("-") {
+		p.next()
+		child, err := p.parseUnary(attribute)
+		if err != nil {
+			return nil, err
+		}
+		return notNode{child}, nil
+	}
+	if t.kind == wordToken && !t.quoted && len(t.text) > 1 && t.text[0] == '-' {
+		p.next()
+		negated := *t
+		negated.text = t.text[1:]
+		if negated.colon >= 0 {
+			negated.colon--
+		}
+		child, err := p.parseWord(&negated, attribute)
+		if err != nil {
+			return nil, err
+		}
+		return notNode{child}, nil
+	}
+	return p.parsePrimary(attribute)
+}
+
+func (p *parser) parsePrimary(attribute string) (node, error) {
+	t := p.next()
+	switch {
+	case t == nil:
+		return nil, fmt.Errorf("unexpected end of query")
+	case t.kind == openToken:
+		return p.parseGroup(attribute)
+	case t.kind == closeToken, t.isKeyword("AND"), t.isKeyword("OR"):
+		return nil, fmt.Errorf("unexpected `%s`", t)
+	}
+	return p.parseWord(t, attribute)
+}
+
+// parseGroup parses the rest of a parenthesized group, after its opening parenthesis.
+func (p *parser) parseGroup(attribute string) (node, error) {
+	child, err := p.parseOr(attribute)
+	if err != nil {
+		return nil, err
+	}
+	if t := p.next(); t == nil || t.kind != closeToken {
+		return nil, fmt.Errorf("missing `)`")
+	}
+	return child, nil
+}
+
+func (p *parser) parseWord(t *token, attribute string) (node, error) {
+	if t.colon < 0 {
+		if attribute == "" {
+			return nil, fmt.Errorf("free text search such as `%s` is not supported, use `attribute:value` terms", t.text)
+		}
+		return newTermNode(attribute, t.text, t.quoted)
+	}
+
+	name, value := t.text[:t.colon], t.text[t.colon+1:]
+	if value == "" && !t.quoted {
+		if next := p.peek(); next != nil && next.kind == openToken {
+			p.next()
+			return p.parseGroup(name)
+		}
+		return nil, fmt.Errorf("missing value for `%s`", name)
+	}
+	return newTermNode(name, value, t.quoted)
+}
+
+func newTermNode(attribute string, value string, quoted bool) (node, error) {
+	n := termNode{attribute: strings.ToLower(attribute)}
+	switch {
+	case n.attribute == "priority", n.attribute == "tags":
+	case strings.HasPrefix(n.attribute, "tags.") && len(n.attribute) > len("tags."):
+		n.tagKey = attribute[len("tags."):]
+		n.attribute = "tags."
+	default:
+		return nil, fmt.Errorf("unsupported attribute `%s`, only `priority`, `tags` and `tags.<key>` are supported", attribute)
+	}
+
+	pattern := regexp.QuoteMeta(value)
+	if !quoted {
+		pattern = strings.ReplaceAll(pattern, `\*`, ".*")
+	}
+	n.value = regexp.MustCompile("(?i)^" + pattern + "$")
+	return n, nil
+}
@@ -0,0 +1,68 @@
+package eventquery
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseErrors(t *testing.T) {
+	cases := map[string]string{
+		"service:web":                "unsupported attribute `service`",
+		"web":                        "free text search such as `web` is not supported",
+		"tags.service:":              "missing value for `tags.service`",
+		"tags.service:(web OR api":   "missing `)`",
+		"tags.service:web)":          "unexpected `)`",
+		"tags.service:web AND":       "unexpected end of query",
+		"OR tags.service:web":        "unexpected `OR`",
+		`tags.service:"web`:          "unterminated quote",
+		"priority:P1 NOT":            "unexpected end of query",
+		"(priority:P1 OR priority:)": "missing value for `priority`",
+	}
+	for query, expected := range cases {
+		_, err := Parse(query)
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected query '%s' to fail with '%s', got '%v' instead.", query, expected, err)
+		}
+	}
+}
+
+func TestMatches(t *testing.T) {
+	event := Event{
+		Tags:     []string{"service:web-store", "env:prod", "team:checkout", "standalone"},
+		Priority: "P2",
+	}
+	cases := map[string]bool{
+		"":                                              true,
+		"tags.service:web-store":                        true,
+		"tags.service:web-api":                          false,
+		"tags.service:web-*":                            true,
+		`tags.service:"web-*"`:                          false,
+		"tags.SERVICE:WEB-STORE":                        true,
+		"tags:env:prod":                                 true,
+		"tags:standalone":                               true,
+		"tags.standalone:*":                             true,
+		"priority:P2":                                   true,
+		"priority:p2":                                   true,
+		"priority:P1":                                   false,
+		"priority:(P1 OR P2)":                           true,
+		"priority:(P1 OR P3)":                           false,
+		"tags.env:prod priority:P2":                     true,
+		"tags.env:prod AND priority:P1":                 false,
+		"tags.env:staging OR priority:P2":               true,
+		"NOT tags.env:prod":                             false,
+		"-tags.env:staging":                             true,
+		"-(tags.env:prod AND priority:P2)":              false,
+		"tags.env:prod AND -priority:(P1 OR P2)":        false,
+		"(tags.env:staging OR tags.team:*) priority:P2": true,
+	}
+	for query, expected := range cases {
+		q, err := Parse(query)
+		if err != nil {
+			t.Errorf("Unexpected error parsing '%s': %v", query, err)
+			continue
+		}
+		if q.Matches(event) != expected {
+			t.Errorf("Expected query '%s' to match: %v", query, expected)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package fwutils
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/rrule"
+)
+
+// OccurrenceWindowType is the element type of computed lists of time windows, such as the `next_occurrences` previews of
+// recurring schedules.
+var OccurrenceWindowType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"start": types.StringType,
+	"end":   types.StringType,
+}}
+
+// ToTerraformOccurrenceWindows converts at most n windows to a `next_occurrences` list, with RFC 3339 times in loc.
+// Windows which never end have a zero End, and a null `end`.
+func ToTerraformOccurrenceWindows(windows []rrule.Window, loc *time.Location, n int) (types.List, diag.Diagnostics) {
+	if len(windows) > n {
+		windows = windows[:n]
+	}
+	occurrences := make([]attr.Value, 0, len(windows))
+	for _, w := range windows {
+		end := types.StringNull()
+		if !w.End.IsZero() {
+			end = types.StringValue(w.End.In(loc).Format(time.RFC3339))
+		}
+		occurrences = append(occurrences, types.ObjectValueMust(OccurrenceWindowType.AttrTypes, map[string]attr.Value{
+			"start": types.StringValue(w.Start.In(loc).Format(time.RFC3339)),
+			"end":   end,
+		}))
+	}
+	return types.ListValue(OccurrenceWindowType, occurrences)
+}
@@ -0,0 +1,212 @@
+package fwutils
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// sdkSingleBlock returns the nested schema of SDK blocks with at most one element, which are converted to objects.
+func sdkSingleBlock(s *sdkschema.Schema) (map[string]*sdkschema.Schema, bool) {
+	if resource, ok := s.Elem.(*sdkschema.Resource); ok && s.Type == sdkschema.TypeList && s.MaxItems == 1 {
+		return resource.Schema, true
+	}
+	return nil, false
+}
+
+// SDKObjectType returns the framework type of an object holding the attributes and blocks of a SDK schema.
+func SDKObjectType(s map[string]*sdkschema.Schema) types.ObjectType {
+	attrTypes := make(map[string]attr.Type, len(s))
+	for key, attribute := range s {
+		attrTypes[key] = sdkAttrType(attribute)
+	}
+	return types.ObjectType{AttrTypes: attrTypes}
+}
+
+func sdkAttrType(s *sdkschema.Schema) attr.Type {
+	if nested, ok := sdkSingleBlock(s); ok {
+		return SDKObjectType(nested)
+	}
+	switch s.Type {
+	case sdkschema.TypeBool:
+		return types.BoolType
+	case sdkschema.TypeInt:
+		return types.Int64Type
+	case sdkschema.TypeFloat:
+		return types.Float64Type
+	case sdkschema.TypeList:
+		return types.ListType{ElemType: sdkElemType(s)}
+	case sdkschema.TypeSet:
+		return types.SetType{ElemType: sdkElemType(s)}
+	case sdkschema.TypeMap:
+		return types.MapType{ElemType: sdkElemType(s)}
+	}
+	return types.StringType
+}
+
+// sdkElemType returns the type of the elements of lists, sets and maps. Maps without element schema hold strings.
+func sdkElemType(s *sdkschema.Schema) attr.Type {
+	switch elem := s.Elem.(type) {
+	case *sdkschema.Resource:
+		return SDKObjectType(elem.Schema)
+	case *sdkschema.Schema:
+		return sdkAttrType(elem)
+	}
+	return types.StringType
+}
+
+// SDKObjectValue converts a value shaped like the SDK state of a block to an object. Missing attributes are null.
+func SDKObjectValue(s map[string]*sdkschema.Schema, value map[string]interface{}) (types.Object, error) {
+	attrTypes := SDKObjectType(s).AttrTypes
+	keys := make([]string, 0, len(s))
+	for key := range s {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	attributes := make(map[string]attr.Value, len(s))
+	for _, key := range keys {
+		attribute, err := sdkAttrValue(s[key], value[key])
+		if err != nil {
+			return types.ObjectNull(attrTypes), fmt.Errorf("%s: %s", key, err)
+		}
+		attributes[key] = attribute
+	}
+	result, diags := types.ObjectValue(attrTypes, attributes)
+	if diags.HasError() {
+		return result, fmt.Errorf("%s", diags.Errors()[0].Detail())
+	}
+	return result, nil
+}
+
+func sdkAttrValue(s *sdkschema.Schema, value interface{}) (attr.Value, error) {
+	attrType := sdkAttrType(s)
+	elements := sdkListElements(value)
+	if value == nil || ((s.Type == sdkschema.TypeList || s.Type == sdkschema.TypeSet) && len(elements) == 0) {
+		return sdkNullValue(attrType), nil
+	}
+
+	if nested, ok := sdkSingleBlock(s); ok {
+		element, _ := elements[0].(map[string]interface{})
+		return SDKObjectValue(nested, element)
+	}
+	switch s.Type {
+	case sdkschema.TypeBool:
+		if v, ok := value.(bool); ok {
+			return types.BoolValue(v), nil
+		}
+	case sdkschema.TypeInt:
+		switch v := value.(type) {
+		case int:
+			return types.Int64Value(int64(v)), nil
+		case int64:
+			return types.Int64Value(v), nil
+		case float64:
+			return types.Int64Value(int64(v)), nil
+		}
+	case sdkschema.TypeFloat:
+		if v, ok := value.(float64); ok {
+			return types.Float64Value(v), nil
+		}
+	case sdkschema.TypeString:
+		if v, ok := value.(string); ok {
+			return types.StringValue(v), nil
+		}
+		return types.StringValue(fmt.Sprint(value)), nil
+	case sdkschema.TypeList, sdkschema.TypeSet:
+		values := make([]attr.Value, 0, len(elements))
+		for _, element := range elements {
+			elementValue, err := sdkElemValue(s, element)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, elementValue)
+		}
+		var result attr.Value
+		var diags diag.Diagnostics
+		if s.Type == sdkschema.TypeSet {
+			result, diags = types.SetValue(sdkElemType(s), values)
+		} else {
+			result, diags = types.ListValue(sdkElemType(s), values)
+		}
+		if diags.HasError() {
+			return nil, fmt.Errorf("%s", diags.Errors()[0].Detail())
+		}
+		return result, nil
+	case sdkschema.TypeMap:
+		elements, ok := value.(map[string]interface{})
+		if !ok {
+			break
+		}
+		values := make(map[string]attr.Value, len(elements))
+		for key, element := range elements {
+			elementValue, err := sdkElemValue(s, element)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", key, err)
+			}
+			values[key] = elementValue
+		}
+		result, diags := types.MapValue(sdkElemType(s), values)
+		if diags.HasError() {
+			return nil, fmt.Errorf("%s", diags.Errors()[0].Detail())
+		}
+		return result, nil
+	}
+	return nil, fmt.Errorf("unexpected value %v", value)
+}
+
+// sdkElemValue converts an element of a list, set or map.
+func sdkElemValue(s *sdkschema.Schema, element interface{}) (attr.Value, error) {
+	switch elem := s.Elem.(type) {
+	case *sdkschema.Resource:
+		elementMap, _ := element.(map[string]interface{})
+		return SDKObjectValue(elem.Schema, elementMap)
+	case *sdkschema.Schema:
+		return sdkAttrValue(elem, element)
+	}
+	return sdkAttrValue(&sdkschema.Schema{Type: sdkschema.TypeString}, element)
+}
+
+// sdkListElements returns the elements of the lists and sets built for the SDK state, which are not always
+// []interface{}.
+func sdkListElements(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		return v
+	case *sdkschema.Set:
+		return v.List()
+	case []map[string]interface{}:
+		elements := make([]interface{}, 0, len(v))
+		for _, element := range v {
+			elements = append(elements, element)
+		}
+		return elements
+	}
+	return nil
+}
+
+func sdkNullValue(attrType attr.Type) attr.Value {
+	switch t := attrType.(type) {
+	case types.ObjectType:
+		return types.ObjectNull(t.AttrTypes)
+	case types.ListType:
+		return types.ListNull(t.ElemType)
+	case types.SetType:
+		return types.SetNull(t.ElemType)
+	case types.MapType:
+		return types.MapNull(t.ElemType)
+	}
+	switch attrType {
+	case types.BoolType:
+		return types.BoolNull()
+	case types.Int64Type:
+		return types.Int64Null()
+	case types.Float64Type:
+		return types.Float64Null()
+	}
+	return types.StringNull()
+}
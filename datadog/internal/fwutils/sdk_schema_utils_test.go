@@ -0,0 +1,94 @@
+package fwutils
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSDKObjectValue(t *testing.T) {
+	s := map[string]*sdkschema.Schema{
+		"name":    {Type: sdkschema.TypeString},
+		"timeout": {Type: sdkschema.TypeInt},
+		"tags":    {Type: sdkschema.TypeList, Elem: &sdkschema.Schema{Type: sdkschema.TypeString}},
+		"headers": {Type: sdkschema.TypeMap},
+		"codes":   {Type: sdkschema.TypeSet, Elem: &sdkschema.Schema{Type: sdkschema.TypeInt}},
+		"params": {
+			Type:     sdkschema.TypeList,
+			MaxItems: 1,
+			Elem: &sdkschema.Resource{Schema: map[string]*sdkschema.Schema{
+				"value": {Type: sdkschema.TypeString},
+				"x":     {Type: sdkschema.TypeFloat},
+			}},
+		},
+	}
+
+	cases := map[string]struct {
+		value    map[string]interface{}
+		expected map[string]string
+	}{
+		"full": {
+			map[string]interface{}{
+				"name":    "step",
+				"timeout": float64(10),
+				"tags":    []interface{}{"foo"},
+				"headers": map[string]interface{}{"accept": "json"},
+				"codes":   sdkschema.NewSet(sdkschema.HashInt, []interface{}{200}),
+				"params":  []map[string]interface{}{{"value": "bar", "x": 1.5}},
+			},
+			map[string]string{
+				"name":    `"step"`,
+				"timeout": "10",
+				"tags":    `["foo"]`,
+				"headers": `{"accept":"json"}`,
+				"codes":   `[200]`,
+				"params":  `{"value":"bar","x":1.500000}`,
+			},
+		},
+		"missing attributes are null": {
+			map[string]interface{}{
+				"name": "step",
+				"tags": []interface{}{},
+			},
+			map[string]string{
+				"name":    `"step"`,
+				"timeout": "<null>",
+				"tags":    "<null>",
+				"headers": "<null>",
+				"codes":   "<null>",
+				"params":  "<null>",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			object, err := SDKObjectValue(s, tc.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !object.Type(nil).Equal(SDKObjectType(s)) {
+				t.Errorf("unexpected type %s", object.Type(nil))
+			}
+			attributes := object.Attributes()
+			for key, expected := range tc.expected {
+				if got := attributes[key].String(); got != expected {
+					t.Errorf("%s: expected %s, got %s", key, expected, got)
+				}
+			}
+		})
+	}
+
+	if _, err := SDKObjectValue(s, map[string]interface{}{"timeout": "ten"}); err == nil {
+		t.Errorf("expected an error converting an invalid value")
+	}
+	if _, err := SDKObjectValue(s, map[string]interface{}{"headers": []interface{}{"json"}}); err == nil {
+		t.Errorf("expected an error converting a list to a map")
+	}
+	if _, ok := SDKObjectType(s).AttrTypes["codes"].(types.SetType); !ok {
+		t.Errorf("expected sets to be sets")
+	}
+	if _, ok := SDKObjectType(s).AttrTypes["params"].(types.ObjectType); !ok {
+		t.Errorf("expected blocks with at most one element to be objects")
+	}
+}
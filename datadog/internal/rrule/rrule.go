@@ -0,0 +1,619 @@
+// Package rrule parses iCalendar (RFC 5545) recurrence rules, and computes their occurrences locally so that resources
+// can validate and preview their recurring schedules at plan time.
+package rrule
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is the FREQ of a recurrence rule.
+type Frequency int
+
+const (
+	Minutely Frequency = iota
+	Hourly
+	Daily
+	Weekly
+	Monthly
+	Yearly
+)
+
+var frequencies = map[string]Frequency{
+	"MINUTELY": Minutely,
+	"HOURLY":   Hourly,
+	"DAILY":    Daily,
+	"WEEKLY":   Weekly,
+	"MONTHLY":  Monthly,
+	"YEARLY":   Yearly,
+}
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// Weekday is an element of BYDAY. N is the ordinal of the weekday in the month or year (`1MO`, `-1FR`), or 0 for
+// every such weekday.
+type Weekday struct {
+	Day time.Weekday
+	N   int
+}
+
+// Rule is a parsed recurrence rule.
+type Rule struct {
+	Freq       Frequency
+	Interval   int
+	Count      int
+	Until      string
+	ByMonth    []int
+	ByMonthDay []int
+	ByDay      []Weekday
+	ByHour     []int
+	ByMinute   []int
+	BySetPos   []int
+	WeekStart  time.Weekday
+
+	// Parts lists the names of the rule parts in the order in which they were set.
+	Parts []string
+}
+
+// Window is a time range during which a recurring event applies.
+type Window struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Location loads a timezone from the tz database, defaulting to UTC. Names which are not part of it, such as the
+// abbreviations some Datadog APIs accept, return an error as the occurrences can't be computed in them.
+func Location(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown timezone `%s`, expected a tz database name such as `America/New_York`", name)
+	}
+	return loc, nil
+}
+
+// The search for the next occurrence stops after maxEmptyPeriods consecutive periods without occurrences which span
+// more than maxEmptyDuration, so that rules which can never match (for example `FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30`)
+// don't loop forever. The duration covers the longest gap between leap days, and keeps sub-daily rules restricted to
+// a few days or months of the year from stopping early.
+const (
+	maxEmptyPeriods  = 1000
+	maxEmptyDuration = 8 * 366 * 24 * time.Hour
+)
+
+// Parse parses a recurrence rule, with or without its `RRULE:` prefix. Properties which are not part of the rule
+// itself, such as `DTSTART` or `DURATION`, are rejected as the start and duration of the events are always set
+// separately.
+func Parse(s string) (*Rule, error) {
+	s = strings.TrimSpace(s)
+	s = strings.TrimPrefix(s, "RRULE:")
+	if s == "" {
+		return nil, fmt.Errorf("the rule is empty")
+	}
+
+	r := &Rule{Interval: 1, WeekStart: time.Monday}
+	seen := make(map[string]bool)
+	freq := false
+	for _, part := range strings.Split(s, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("invalid rule part `%s`, expected NAME=VALUE", part)
+		}
+		name = strings.ToUpper(name)
+		if seen[name] {
+			return nil, fmt.Errorf("`%s` is set more than once", name)
+		}
+		seen[name] = true
+		r.Parts = append(r.Parts, name)
+
+		var err error
+		switch name {
+		case "FREQ":
+			f, ok := frequencies[strings.ToUpper(value)]
+			if !ok {
+				return nil, fmt.Errorf("unsupported FREQ `%s`", value)
+			}
+			r.Freq, freq = f, true
+		case "INTERVAL":
+			r.Interval, err = parseInt(name, value, 1, 0)
+		case "COUNT":
+			r.Count, err = parseInt(name, value, 1, 0)
+		case "UNTIL":
+			if _, err = parseUntil(value, time.UTC); err == nil {
+				r.Until = value
+			}
+		case "BYMONTH":
+			r.ByMonth, err = parseInts(name, value, 1, 12, false)
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseInts(name, value, 1, 31, true)
+		case "BYHOUR":
+			r.ByHour, err = parseInts(name, value, 0, 23, false)
+		case "BYMINUTE":
+			r.ByMinute, err = parseInts(name, value, 0, 59, false)
+		case "BYSETPOS":
+			r.BySetPos, err = parseInts(name, value, 1, 366, true)
+		case "BYDAY":
+			r.ByDay, err = parseWeekdays(value)
+		case "WKST":
+			day, ok := weekdays[strings.ToUpper(value)]
+			if !ok {
+				return nil, fmt.Errorf("invalid WKST `%s`", value)
+			}
+			r.WeekStart = day
+		case "DTSTART", "DTEND", "DURATION":
+			return nil, fmt.Errorf("`%s` is not supported in the rule, the start and duration are set separately", name)
+		default:
+			return nil, fmt.Errorf("unsupported rule part `%s`", name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !freq {
+		return nil, fmt.Errorf("`FREQ` is required")
+	}
+	if r.Count > 0 && r.Until != "" {
+		return nil, fmt.Errorf("`COUNT` and `UNTIL` can't be used together")
+	}
+	for _, d := range r.ByDay {
+		if d.N != 0 && r.Freq != Monthly && r.Freq != Yearly {
+			return nil, fmt.Errorf("BYDAY ordinals such as `%d` are only supported with a MONTHLY or YEARLY FREQ", d.N)
+		}
+	}
+	if len(r.ByMonthDay) > 0 && r.Freq == Weekly {
+		return nil, fmt.Errorf("BYMONTHDAY can't be used with a WEEKLY FREQ")
+	}
+	if len(r.BySetPos) > 0 && len(r.ByMonth)+len(r.ByMonthDay)+len(r.ByDay)+len(r.ByHour)+len(r.ByMinute) == 0 {
+		return nil, fmt.Errorf("BYSETPOS must be used with another BYxxx rule part")
+	}
+	return r, nil
+}
+
+// Has returns whether the rule part is set.
+func (r *Rule) Has(part string) bool {
+	for _, p := range r.Parts {
+		if p == part {
+			return true
+		}
+	}
+	return false
+}
+
+// Occurrences returns up to n occurrences of the rule which start at or after `after`. The occurrences are computed in
+// the location of dtstart, which is the first occurrence of the rule.
+func (r *Rule) Occurrences(dtstart time.Time, after time.Time, n int) []time.Time {
+	var occurrences []time.Time
+	r.iterate(dtstart, func(t time.Time) bool {
+		if !t.Before(after) {
+			occurrences = append(occurrences, t)
+		}
+		return len(occurrences) < n
+	})
+	return occurrences
+}
+
+// Between returns up to limit occurrences of the rule which start in [from, to).
+func (r *Rule) Between(dtstart time.Time, from time.Time, to time.Time, limit int) []time.Time {
+	var occurrences []time.Time
+	r.iterate(dtstart, func(t time.Time) bool {
+		if !t.Before(to) {
+			return false
+		}
+		if !t.Before(from) {
+			occurrences = append(occurrences, t)
+		}
+		return len(occurrences) < limit
+	})
+	return occurrences
+}
+
+// Windows returns up to n windows of the given duration which end after `after`.
+func (r *Rule) Windows(dtstart time.Time, duration time.Duration, after time.Time, n int) []Window {
+	var windows []Window
+	r.iterate(dtstart, func(t time.Time) bool {
+		if t.Add(duration).After(after) {
+			windows = append(windows, Window{Start: t, End: t.Add(duration)})
+		}
+		return len(windows) < n
+	})
+	return windows
+}
+
+// Overlap returns the first pair of windows of a and b which overlap. Both lists must be sorted by start.
+func Overlap(a []Window, b []Window) (Window, Window, bool) {
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if a[i].Start.Before(b[j].End) && b[j].Start.Before(a[i].End) {
+			return a[i], b[j], true
+		}
+		if a[i].End.Before(b[j].End) {
+			i++
+		} else {
+			j++
+		}
+	}
+	return Window{}, Window{}, false
+}
+
+// iterate calls yield with each occurrence of the rule in order, until it returns false or the rule ends.
+func (r *Rule) iterate(dtstart time.Time, yield func(time.Time) bool) {
+	loc := dtstart.Location()
+	var until time.Time
+	if r.Until != "" {
+		until, _ = parseUntil(r.Until, loc)
+	}
+
+	count := 0
+	empty := 0
+	last := dtstart
+	for period := 0; empty < maxEmptyPeriods || r.periodStart(dtstart, period*r.Interval).Sub(last) <= maxEmptyDuration; {
+		candidates := r.expand(dtstart, period*r.Interval)
+		if len(candidates) == 0 {
+			empty++
+			period = r.nextPeriod(dtstart, period)
+			continue
+		}
+		empty = 0
+		last = candidates[len(candidates)-1]
+		period++
+		for _, t := range candidates {
+			if t.Before(dtstart) {
+				continue
+			}
+			if !until.IsZero() && t.After(until) {
+				return
+			}
+			count++
+			if !yield(t) {
+				return
+			}
+			if r.Count > 0 && count >= r.Count {
+				return
+			}
+		}
+	}
+}
+
+// periodStart returns the start of the period which is `offset` frequency units after the one of dtstart.
+func (r *Rule) periodStart(dtstart time.Time, offset int) time.Time {
+	switch r.Freq {
+	case Yearly:
+		return dtstart.AddDate(offset, 0, 0)
+	case Monthly:
+		return dtstart.AddDate(0, offset, 0)
+	case Weekly:
+		return dtstart.AddDate(0, 0, 7*offset)
+	case Daily:
+		return dtstart.AddDate(0, 0, offset)
+	case Hourly:
+		return dtstart.Add(time.Duration(offset) * time.Hour)
+	}
+	return dtstart.Add(time.Duration(offset) * time.Minute)
+}
+
+// nextPeriod returns the period to expand after the given one, which has no occurrences. Sub-daily rules skip the
+// periods until the next day when the day doesn't match, and minutely rules the periods until the next hour or minute
+// which can match, so that they don't expand every hour or minute of the days they can't occur on.
+func (r *Rule) nextPeriod(dtstart time.Time, period int) int {
+	if r.Freq != Hourly && r.Freq != Minutely {
+		return period + 1
+	}
+	t := r.periodStart(dtstart, period*r.Interval)
+	loc := t.Location()
+	var next time.Time
+	// The skipped periods are rounded down so that no occurrence is missed, with a margin of an hour for daylight
+	// saving time changes when skipping days
+	margin := time.Duration(0)
+	switch {
+	case len(r.filterDays([]time.Time{date(t.Year(), t.Month(), t.Day(), loc)}, true)) == 0:
+		next = date(t.Year(), t.Month(), t.Day()+1, loc)
+		margin = time.Hour
+	case r.Freq == Minutely && len(r.ByHour) > 0 && !contains(r.ByHour, t.Hour()):
+		next = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+	case r.Freq == Minutely && len(r.ByMinute) > 0 && !contains(r.ByMinute, t.Minute()):
+		next = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		for _, minute := range sorted(r.ByMinute) {
+			if minute > t.Minute() {
+				next = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), minute, 0, 0, loc)
+				break
+			}
+		}
+	default:
+		return period + 1
+	}
+
+	unit := time.Minute
+	if r.Freq == Hourly {
+		unit = time.Hour
+	}
+	skip := int((next.Sub(t) - margin) / (unit * time.Duration(r.Interval)))
+	if skip < 1 {
+		skip = 1
+	}
+	return period + skip
+}
+
+// expand returns the sorted occurrences of the period which is `offset` frequency units after the one of dtstart.
+func (r *Rule) expand(dtstart time.Time, offset int) []time.Time {
+	loc := dtstart.Location()
+	y, m, d := dtstart.Date()
+	hour, minute, second := dtstart.Clock()
+
+	var days []time.Time
+	switch r.Freq {
+	case Yearly:
+		year := y + offset
+		months := r.ByMonth
+		if len(months) == 0 {
+			if len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+				months = []int{int(m)}
+			} else {
+				months = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+			}
+		}
+		if len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+			for _, month := range months {
+				if day := date(year, time.Month(month), d, loc); day.Day() == d {
+					days = append(days, day)
+				}
+			}
+			break
+		}
+		if len(r.ByMonth) == 0 && len(r.ByMonthDay) == 0 {
+			// BYDAY ordinals are relative to the year without BYMONTH
+			days = r.filterDays(daysBetween(date(year, 1, 1, loc), date(year+1, 1, 1, loc)), false)
+			break
+		}
+		for _, month := range months {
+			start := date(year, time.Month(month), 1, loc)
+			days = append(days, r.filterDays(daysBetween(start, start.AddDate(0, 1, 0)), true)...)
+		}
+	case Monthly:
+		start := date(y, m+time.Month(offset), 1, loc)
+		if len(r.ByMonth) > 0 && !contains(r.ByMonth, int(start.Month())) {
+			return nil
+		}
+		if len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+			if day := date(start.Year(), start.Month(), d, loc); day.Day() == d {
+				days = append(days, day)
+			}
+			break
+		}
+		days = r.filterDays(daysBetween(start, start.AddDate(0, 1, 0)), true)
+	case Weekly:
+		start := date(y, m, d+7*offset, loc)
+		start = start.AddDate(0, 0, -((int(start.Weekday()) - int(r.WeekStart) + 7) % 7))
+		for _, day := range daysBetween(start, start.AddDate(0, 0, 7)) {
+			if len(r.ByDay) == 0 && day.Weekday() != dtstart.Weekday() {
+				continue
+			}
+			if len(r.ByMonth) > 0 && !contains(r.ByMonth, int(day.Month())) {
+				continue
+			}
+			days = append(days, day)
+		}
+		if len(r.ByDay) > 0 {
+			days = r.filterDays(days, true)
+		}
+	case Daily:
+		days = r.filterDays([]time.Time{date(y, m, d+offset, loc)}, true)
+	case Hourly, Minutely:
+		var t time.Time
+		if r.Freq == Hourly {
+			t = time.Date(y, m, d, hour+offset, minute, second, 0, loc)
+		} else {
+			t = time.Date(y, m, d, hour, minute+offset, second, 0, loc)
+		}
+		if len(r.filterDays([]time.Time{date(t.Year(), t.Month(), t.Day(), loc)}, true)) == 0 {
+			return nil
+		}
+		if len(r.ByHour) > 0 && !contains(r.ByHour, t.Hour()) {
+			return nil
+		}
+		if r.Freq == Minutely && len(r.ByMinute) > 0 && !contains(r.ByMinute, t.Minute()) {
+			return nil
+		}
+		if r.Freq == Hourly && len(r.ByMinute) > 0 {
+			var occurrences []time.Time
+			for _, mi := range sorted(r.ByMinute) {
+				occurrences = append(occurrences, time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), mi, second, 0, loc))
+			}
+			return r.setPos(occurrences)
+		}
+		return []time.Time{t}
+	}
+
+	hours := []int{hour}
+	if len(r.ByHour) > 0 {
+		hours = sorted(r.ByHour)
+	}
+	minutes := []int{minute}
+	if len(r.ByMinute) > 0 {
+		minutes = sorted(r.ByMinute)
+	}
+	var occurrences []time.Time
+	for _, day := range days {
+		for _, h := range hours {
+			for _, mi := range minutes {
+				occurrences = append(occurrences, time.Date(day.Year(), day.Month(), day.Day(), h, mi, second, 0, loc))
+			}
+		}
+	}
+	sort.Slice(occurrences, func(i, j int) bool { return occurrences[i].Before(occurrences[j]) })
+	return r.setPos(occurrences)
+}
+
+// filterDays keeps the days matching BYMONTH, BYMONTHDAY and BYDAY. The ordinals of BYDAY are relative to the days
+// given, which are either a month or a year.
+func (r *Rule) filterDays(days []time.Time, monthly bool) []time.Time {
+	var filtered []time.Time
+	for i, day := range days {
+		if len(r.ByMonth) > 0 && !contains(r.ByMonth, int(day.Month())) {
+			continue
+		}
+		if len(r.ByMonthDay) > 0 {
+			last := date(day.Year(), day.Month()+1, 0, day.Location()).Day()
+			if !contains(r.ByMonthDay, day.Day()) && !contains(r.ByMonthDay, day.Day()-last-1) {
+				continue
+			}
+		}
+		if len(r.ByDay) > 0 && !r.matchesWeekday(days, i) {
+			continue
+		}
+		filtered = append(filtered, day)
+	}
+	return filtered
+}
+
+func (r *Rule) matchesWeekday(days []time.Time, i int) bool {
+	day := days[i]
+	for _, wd := range r.ByDay {
+		if wd.Day != day.Weekday() {
+			continue
+		}
+		if wd.N == 0 {
+			return true
+		}
+		// Ordinal of the weekday from the start and from the end of the days
+		n, last := 0, 0
+		for j, other := range days {
+			if other.Weekday() == wd.Day {
+				if j <= i {
+					n++
+				}
+				if j >= i {
+					last++
+				}
+			}
+		}
+		if wd.N == n || wd.N == -last {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Rule) setPos(occurrences []time.Time) []time.Time {
+	if len(r.BySetPos) == 0 {
+		return occurrences
+	}
+	var selected []time.Time
+	for _, pos := range r.BySetPos {
+		i := pos - 1
+		if pos < 0 {
+			i = len(occurrences) + pos
+		}
+		if i >= 0 && i < len(occurrences) {
+			selected = append(selected, occurrences[i])
+		}
+	}
+	sort.Slice(selected, func(i, j int) bool { return selected[i].Before(selected[j]) })
+	return selected
+}
+
+func date(year int, month time.Month, day int, loc *time.Location) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, loc)
+}
+
+func daysBetween(start time.Time, end time.Time) []time.Time {
+	var days []time.Time
+	for day := start; day.Before(end); day = date(day.Year(), day.Month(), day.Day()+1, day.Location()) {
+		days = append(days, day)
+	}
+	return days
+}
+
+func contains(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func sorted(values []int) []int {
+	s := append([]int(nil), values...)
+	sort.Ints(s)
+	return s
+}
+
+func parseInt(name string, value string, min int, max int) (int, error) {
+	i, err := strconv.Atoi(value)
+	if err != nil || i < min || (max > 0 && i > max) {
+		if max > 0 {
+			return 0, fmt.Errorf("invalid %s `%s`, expected an integer between %d and %d", name, value, min, max)
+		}
+		return 0, fmt.Errorf("invalid %s `%s`, expected an integer greater than or equal to %d", name, value, min)
+	}
+	return i, nil
+}
+
+func parseInts(name string, value string, min int, max int, negative bool) ([]int, error) {
+	var values []int
+	for _, v := range strings.Split(value, ",") {
+		i, err := strconv.Atoi(v)
+		abs := i
+		if negative && i < 0 {
+			abs = -i
+		}
+		if err != nil || abs < min || abs > max || (negative && i == 0) {
+			return nil, fmt.Errorf("invalid %s value `%s`", name, v)
+		}
+		values = append(values, i)
+	}
+	return values, nil
+}
+
+func parseWeekdays(value string) ([]Weekday, error) {
+	var days []Weekday
+	for _, v := range strings.Split(value, ",") {
+		v = strings.ToUpper(v)
+		if len(v) < 2 {
+			return nil, fmt.Errorf("invalid BYDAY value `%s`", v)
+		}
+		day, ok := weekdays[v[len(v)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid BYDAY value `%s`", v)
+		}
+		n := 0
+		if ordinal := v[:len(v)-2]; ordinal != "" {
+			var err error
+			n, err = strconv.Atoi(ordinal)
+			if err != nil || n == 0 || n > 53 || n < -53 {
+				return nil, fmt.Errorf("invalid BYDAY value `%s`", v)
+			}
+		}
+		days = append(days, Weekday{Day: day, N: n})
+	}
+	return days, nil
+}
+
+// parseUntil parses UNTIL as a UTC date-time, a local date-time or a date. Local date-times and dates are in loc.
+func parseUntil(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("20060102T150405", value, loc); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("20060102", value, loc); err == nil {
+		// A date includes the whole day
+		return t.AddDate(0, 0, 1).Add(-time.Second), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid UNTIL `%s`, expected a date-time such as `20240101T000000Z`", value)
+}
@@ -0,0 +1,223 @@
+package rrule
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseErrors(t *testing.T) {
+	cases := map[string]string{
+		"":                                  "the rule is empty",
+		"INTERVAL=2":                        "`FREQ` is required",
+		"FREQ=SECONDLY":                     "unsupported FREQ `SECONDLY`",
+		"FREQ=DAILY;FREQ=WEEKLY":            "`FREQ` is set more than once",
+		"FREQ=DAILY;INTERVAL=0":             "invalid INTERVAL `0`",
+		"FREQ=DAILY;COUNT=2;UNTIL=20240101": "`COUNT` and `UNTIL` can't be used together",
+		"FREQ=DAILY;UNTIL=tomorrow":         "invalid UNTIL `tomorrow`",
+		"FREQ=WEEKLY;BYDAY=1MO":             "BYDAY ordinals such as `1` are only supported with a MONTHLY or YEARLY FREQ",
+		"FREQ=WEEKLY;BYMONTHDAY=1":          "BYMONTHDAY can't be used with a WEEKLY FREQ",
+		"FREQ=MONTHLY;BYMONTHDAY=32":        "invalid BYMONTHDAY value `32`",
+		"FREQ=MONTHLY;BYDAY=XX":             "invalid BYDAY value `XX`",
+		"FREQ=MONTHLY;BYSETPOS=1":           "BYSETPOS must be used with another BYxxx rule part",
+		"DTSTART=20240101T000000Z":          "`DTSTART` is not supported in the rule",
+		"FREQ=DAILY;FOO=BAR":                "unsupported rule part `FOO`",
+		"FREQ=DAILY;INTERVAL":               "invalid rule part `INTERVAL`",
+	}
+	for rule, expected := range cases {
+		_, err := Parse(rule)
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected rule '%s' to fail with '%s', got '%v' instead.", rule, expected, err)
+		}
+	}
+}
+
+func TestOccurrences(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("the timezone database is not available")
+	}
+	utcStart := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	cases := []struct {
+		rule     string
+		dtstart  time.Time
+		after    time.Time
+		n        int
+		expected []string
+	}{
+		{
+			rule:     "RRULE:FREQ=DAILY;INTERVAL=10;COUNT=3",
+			dtstart:  utcStart,
+			n:        5,
+			expected: []string{"2024-01-01T09:00:00Z", "2024-01-11T09:00:00Z", "2024-01-21T09:00:00Z"},
+		},
+		{
+			rule:     "FREQ=DAILY;COUNT=5",
+			dtstart:  utcStart,
+			after:    time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+			n:        5,
+			expected: []string{"2024-01-03T09:00:00Z", "2024-01-04T09:00:00Z", "2024-01-05T09:00:00Z"},
+		},
+		{
+			rule:     "FREQ=WEEKLY;BYDAY=MO,WE",
+			dtstart:  utcStart,
+			n:        4,
+			expected: []string{"2024-01-01T09:00:00Z", "2024-01-03T09:00:00Z", "2024-01-08T09:00:00Z", "2024-01-10T09:00:00Z"},
+		},
+		{
+			rule:     "FREQ=WEEKLY;INTERVAL=2;UNTIL=20240201T000000Z",
+			dtstart:  utcStart,
+			n:        5,
+			expected: []string{"2024-01-01T09:00:00Z", "2024-01-15T09:00:00Z", "2024-01-29T09:00:00Z"},
+		},
+		{
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=-1",
+			dtstart:  utcStart,
+			n:        3,
+			expected: []string{"2024-01-31T09:00:00Z", "2024-02-29T09:00:00Z", "2024-03-31T09:00:00Z"},
+		},
+		{
+			rule:     "FREQ=MONTHLY",
+			dtstart:  time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC),
+			n:        3,
+			expected: []string{"2024-01-31T09:00:00Z", "2024-03-31T09:00:00Z", "2024-05-31T09:00:00Z"},
+		},
+		{
+			rule:     "FREQ=MONTHLY;BYDAY=2TU,-1FR",
+			dtstart:  utcStart,
+			n:        4,
+			expected: []string{"2024-01-09T09:00:00Z", "2024-01-26T09:00:00Z", "2024-02-13T09:00:00Z", "2024-02-23T09:00:00Z"},
+		},
+		{
+			rule:     "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1",
+			dtstart:  utcStart,
+			n:        2,
+			expected: []string{"2024-01-31T09:00:00Z", "2024-02-29T09:00:00Z"},
+		},
+		{
+			rule:     "FREQ=YEARLY;BYMONTH=11;BYDAY=4TH",
+			dtstart:  utcStart,
+			n:        2,
+			expected: []string{"2024-11-28T09:00:00Z", "2025-11-27T09:00:00Z"},
+		},
+		{
+			rule:     "FREQ=HOURLY;INTERVAL=6;BYMINUTE=0,30;COUNT=4",
+			dtstart:  utcStart,
+			n:        5,
+			expected: []string{"2024-01-01T09:00:00Z", "2024-01-01T09:30:00Z", "2024-01-01T15:00:00Z", "2024-01-01T15:30:00Z"},
+		},
+		{
+			// Occurrences keep their wall-clock time across daylight saving time changes
+			rule:     "FREQ=DAILY;COUNT=3",
+			dtstart:  time.Date(2024, 3, 9, 9, 0, 0, 0, newYork),
+			n:        3,
+			expected: []string{"2024-03-09T14:00:00Z", "2024-03-10T13:00:00Z", "2024-03-11T13:00:00Z"},
+		},
+		{
+			rule:    "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30",
+			dtstart: utcStart,
+			n:       1,
+		},
+		{
+			// Sub-daily rules keep looking for occurrences across the days and months they don't match
+			rule:     "FREQ=MINUTELY;BYHOUR=9;BYMINUTE=0",
+			dtstart:  utcStart,
+			n:        3,
+			expected: []string{"2024-01-01T09:00:00Z", "2024-01-02T09:00:00Z", "2024-01-03T09:00:00Z"},
+		},
+		{
+			rule:     "FREQ=HOURLY;BYMONTH=6",
+			dtstart:  utcStart,
+			n:        2,
+			expected: []string{"2024-06-01T00:00:00Z", "2024-06-01T01:00:00Z"},
+		},
+		{
+			rule:     "FREQ=MINUTELY;INTERVAL=5;BYMONTH=3;BYDAY=MO;BYHOUR=10,12;BYMINUTE=5,40",
+			dtstart:  utcStart,
+			n:        3,
+			expected: []string{"2024-03-04T10:05:00Z", "2024-03-04T10:40:00Z", "2024-03-04T12:05:00Z"},
+		},
+		{
+			rule:     "FREQ=DAILY;BYMONTH=2;BYMONTHDAY=29",
+			dtstart:  time.Date(2096, 3, 1, 9, 0, 0, 0, time.UTC),
+			n:        1,
+			expected: []string{"2104-02-29T09:00:00Z"},
+		},
+		{
+			rule:    "FREQ=MINUTELY;BYMONTH=2;BYMONTHDAY=30",
+			dtstart: utcStart,
+			n:       1,
+		},
+	}
+	for _, c := range cases {
+		r, err := Parse(c.rule)
+		if err != nil {
+			t.Fatalf("Unexpected error parsing rule '%s': %v", c.rule, err)
+		}
+		var actual []string
+		for _, o := range r.Occurrences(c.dtstart, c.after, c.n) {
+			actual = append(actual, o.UTC().Format(time.RFC3339))
+		}
+		if strings.Join(actual, ",") != strings.Join(c.expected, ",") {
+			t.Errorf("Expected rule '%s' to occur at %v, got %v instead.", c.rule, c.expected, actual)
+		}
+	}
+}
+
+func TestLocation(t *testing.T) {
+	if loc, err := Location(""); err != nil || loc != time.UTC {
+		t.Errorf("Expected an empty timezone to default to UTC, got %v and %v instead.", loc, err)
+	}
+	if _, err := Location("America/New_York"); err != nil {
+		t.Skip("the timezone database is not available")
+	}
+	if _, err := Location("Mars/Olympus_Mons"); err == nil || !strings.Contains(err.Error(), "unknown timezone `Mars/Olympus_Mons`") {
+		t.Errorf("Expected an unknown timezone to fail, got '%v' instead.", err)
+	}
+}
+
+func TestOverlap(t *testing.T) {
+	dtstart := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	daily, _ := Parse("FREQ=DAILY")
+	weekly, _ := Parse("FREQ=WEEKLY;BYDAY=WE")
+
+	a := daily.Windows(dtstart, time.Hour, dtstart, 30)
+	b := weekly.Windows(dtstart.Add(90*time.Minute), time.Hour, dtstart, 5)
+	if _, _, ok := Overlap(a, b); ok {
+		t.Errorf("Expected windows not to overlap.")
+	}
+
+	b = weekly.Windows(dtstart.Add(30*time.Minute), time.Hour, dtstart, 5)
+	wa, wb, ok := Overlap(a, b)
+	if !ok {
+		t.Fatalf("Expected windows to overlap.")
+	}
+	if !wa.Start.Equal(time.Date(2024, 1, 3, 9, 0, 0, 0, time.UTC)) || !wb.Start.Equal(time.Date(2024, 1, 3, 9, 30, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected overlapping windows %v and %v.", wa, wb)
+	}
+}
+
+func TestSubset(t *testing.T) {
+	cases := []struct {
+		subset   Subset
+		rule     string
+		expected string
+	}{
+		{subset: SloCorrections, rule: "RRULE:FREQ=DAILY;INTERVAL=10;COUNT=5"},
+		{subset: SloCorrections, rule: "FREQ=WEEKLY;BYDAY=MO", expected: "`BYDAY` is not supported for SLO corrections, supported rule parts are `FREQ`, `INTERVAL`, `COUNT` and `UNTIL`"},
+		{subset: MonitorSchedules, rule: "FREQ=WEEKLY;BYDAY=MO,TU;BYHOUR=9;BYMINUTE=30"},
+		{subset: MonitorSchedules, rule: "FREQ=HOURLY;INTERVAL=2", expected: "FREQ `HOURLY` is not supported for monitor custom schedules, supported frequencies are `DAILY`, `WEEKLY` and `MONTHLY`"},
+		{subset: MonitorSchedules, rule: "FREQ=DAILY;COUNT=3", expected: "`COUNT` is not supported for monitor custom schedules"},
+		{subset: Downtimes, rule: "FREQ=MONTHLY;BYSETPOS=3;BYDAY=WE;INTERVAL=1"},
+		{subset: Downtimes, rule: "FREQ=MONTHLY;DTSTART=20240101T000000Z", expected: "`DTSTART` is not supported in the rule"},
+	}
+	for _, c := range cases {
+		_, err := c.subset.Parse(c.rule)
+		if c.expected == "" && err != nil {
+			t.Errorf("Unexpected error parsing rule '%s' for %s: %v", c.rule, c.subset.Name, err)
+		}
+		if c.expected != "" && (err == nil || !strings.Contains(err.Error(), c.expected)) {
+			t.Errorf("Expected rule '%s' to fail for %s with '%s', got '%v' instead.", c.rule, c.subset.Name, c.expected, err)
+		}
+	}
+}
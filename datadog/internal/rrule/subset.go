@@ -0,0 +1,87 @@
+package rrule
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Subset is the part of RFC 5545 that a Datadog API accepts in its recurrence rules.
+type Subset struct {
+	// Name is used in error messages, for example `SLO corrections`.
+	Name string
+	// Parts are the supported rule parts. Every part known to Parse is supported when empty.
+	Parts []string
+	// Freqs are the supported frequencies. Every frequency known to Parse is supported when empty.
+	Freqs []string
+}
+
+var (
+	// Downtimes is the subset supported by `datadog_downtime_schedule` recurrences.
+	Downtimes = Subset{Name: "downtimes"}
+	// SloCorrections is the subset supported by `datadog_slo_correction`.
+	SloCorrections = Subset{
+		Name:  "SLO corrections",
+		Parts: []string{"FREQ", "INTERVAL", "COUNT", "UNTIL"},
+	}
+	// MonitorSchedules is the subset supported by the custom schedules of `datadog_monitor`.
+	MonitorSchedules = Subset{
+		Name:  "monitor custom schedules",
+		Parts: []string{"FREQ", "INTERVAL", "BYDAY", "BYMONTHDAY", "BYHOUR", "BYMINUTE"},
+		Freqs: []string{"DAILY", "WEEKLY", "MONTHLY"},
+	}
+)
+
+// Parse parses a recurrence rule and checks that it only uses the subset.
+func (s Subset) Parse(rule string) (*Rule, error) {
+	r, err := Parse(rule)
+	if err != nil {
+		return nil, err
+	}
+	if len(s.Parts) > 0 {
+		for _, part := range r.Parts {
+			if !containsString(s.Parts, part) {
+				return nil, fmt.Errorf("`%s` is not supported for %s, supported rule parts are %s", part, s.Name, quote(s.Parts))
+			}
+		}
+	}
+	if len(s.Freqs) > 0 {
+		for name, freq := range frequencies {
+			if freq == r.Freq && !containsString(s.Freqs, name) {
+				return nil, fmt.Errorf("FREQ `%s` is not supported for %s, supported frequencies are %s", name, s.Name, quote(s.Freqs))
+			}
+		}
+	}
+	return r, nil
+}
+
+// Description describes the subset, to be used in schema descriptions.
+func (s Subset) Description() string {
+	if len(s.Parts) == 0 {
+		return "Supported rule parts are `FREQ`, `INTERVAL`, `COUNT`, `UNTIL`, `BYMONTH`, `BYMONTHDAY`, `BYDAY`, `BYHOUR`, `BYMINUTE`, `BYSETPOS` and `WKST`."
+	}
+	description := fmt.Sprintf("Supported rule parts for %s are %s.", s.Name, quote(s.Parts))
+	if len(s.Freqs) > 0 {
+		description += fmt.Sprintf(" Supported frequencies are %s.", quote(s.Freqs))
+	}
+	return description
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func quote(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = "`" + v + "`"
+	}
+	if len(quoted) == 1 {
+		return quoted[0]
+	}
+	return strings.Join(quoted[:len(quoted)-1], ", ") + " and " + quoted[len(quoted)-1]
+}
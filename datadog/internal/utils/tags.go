@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"regexp"
+	"strings"
 	"unicode"
 	"unicode/utf8"
 )
@@ -100,3 +102,26 @@ func isValidASCIIStartChar(c byte) bool {
 func isValidASCIITagChar(c byte) bool {
 	return isValidASCIIStartChar(c) || ('0' <= c && c <= '9') || c == '.' || c == '/' || c == '-'
 }
+
+// TagMatcher compiles a tag filter, where `*` matches any sequence of characters, into an anchored regexp.
+func TagMatcher(filter string) *regexp.Regexp {
+	pattern := strings.ReplaceAll(regexp.QuoteMeta(filter), `\*`, ".*")
+	return regexp.MustCompile("^" + pattern + "$")
+}
+
+// TagsMatch returns true when every matcher matches at least one of the tags.
+func TagsMatch(tags []string, matchers []*regexp.Regexp) bool {
+	for _, matcher := range matchers {
+		found := false
+		for _, tag := range tags {
+			if matcher.MatchString(tag) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
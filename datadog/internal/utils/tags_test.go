@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"regexp"
 	"testing"
 )
 
@@ -23,3 +24,28 @@ func TestTagNormalization(t *testing.T) {
 		}
 	}
 }
+
+func TestTagsMatch(t *testing.T) {
+	cases := []struct {
+		tags     []string
+		filters  []string
+		expected bool
+	}{
+		{[]string{"team:core"}, []string{"team:core"}, true},
+		{[]string{"team:core"}, []string{"team:*"}, true},
+		{[]string{"team:core"}, []string{"team"}, false},
+		{[]string{"team:core", "env:prod"}, []string{"team:*", "env:prod"}, true},
+		{[]string{"team:core"}, []string{"team:*", "env:prod"}, false},
+		{[]string{"team:core"}, []string{"team:c.re"}, false},
+		{nil, nil, true},
+	}
+	for _, c := range cases {
+		var matchers []*regexp.Regexp
+		for _, filter := range c.filters {
+			matchers = append(matchers, TagMatcher(filter))
+		}
+		if matched := TagsMatch(c.tags, matchers); matched != c.expected {
+			t.Errorf("Expected tags %v matching filters %v to be %t, got %t instead.", c.tags, c.filters, c.expected, matched)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/rrule"
+)
+
+type rruleValidator struct {
+	subset rrule.Subset
+}
+
+func (v rruleValidator) Description(context.Context) string {
+	return fmt.Sprintf("value must be a valid RFC 5545 recurrence rule. %s", v.subset.Description())
+}
+
+func (v rruleValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rruleValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := v.subset.Parse(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid rrule", err.Error())
+	}
+}
+
+// RRuleValidator ensures a string is a recurrence rule in the subset supported by the API.
+func RRuleValidator(subset rrule.Subset) validator.String {
+	return rruleValidator{subset}
+}
+
+// ValidateRRule ensures a string is a recurrence rule in the subset supported by the API.
+func ValidateRRule(subset rrule.Subset) schema.SchemaValidateDiagFunc {
+	return func(v any, path cty.Path) diag.Diagnostics {
+		value, ok := v.(string)
+		if !ok {
+			return nil
+		}
+		if _, err := subset.Parse(value); err != nil {
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       "Invalid rrule",
+				Detail:        err.Error(),
+				AttributePath: path,
+			}}
+		}
+		return nil
+	}
+}
+
+// ValidateRRuleTimezone ensures a string is a timezone of the tz database, in which the occurrences of a recurrence
+// rule can be computed.
+func ValidateRRuleTimezone(v any, path cty.Path) diag.Diagnostics {
+	value, ok := v.(string)
+	if !ok {
+		return nil
+	}
+	if _, err := rrule.Location(value); err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid timezone",
+			Detail:        err.Error(),
+			AttributePath: path,
+		}}
+	}
+	return nil
+}
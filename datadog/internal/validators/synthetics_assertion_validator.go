@@ -0,0 +1,141 @@
+package validators
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/antchfx/xpath"
+	"github.com/dlclark/regexp2"
+	"github.com/ohler55/ojg/jp"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// Synthetics values can reference variables, e.g. `{{ MY_VARIABLE }}`, which are only resolved at run time
+var syntheticsTemplateVariableRegex = regexp.MustCompile(`{{\s*([^{}]*?)\s*}}`)
+
+// syntheticsJSONSchemaDrafts maps the metaschemas supported by JSON Schema assertions to their draft
+var syntheticsJSONSchemaDrafts = map[string]*jsonschema.Draft{
+	"draft-07": jsonschema.Draft7,
+	"draft-06": jsonschema.Draft6,
+}
+
+// ValidateSyntheticsAssertion checks the expressions of a synthetics test assertion without calling the API:
+// regex targets are compiled, JSONPath and XPath expressions are parsed and JSON Schema documents are validated
+// against their metaschema. Empty values, which are unknown at plan time, and values referencing variables are skipped.
+func ValidateSyntheticsAssertion(assertion map[string]interface{}) error {
+	assertionType, _ := assertion["type"].(string)
+	operator, _ := assertion["operator"].(string)
+
+	switch operator {
+	case "validatesJSONSchema":
+		if target := firstSyntheticsAssertionTarget(assertion, "targetjsonschema"); target != nil {
+			document, _ := target["jsonschema"].(string)
+			metaschema, _ := target["metaschema"].(string)
+			if err := validateSyntheticsJSONSchema(document, metaschema); err != nil {
+				return fmt.Errorf("invalid `targetjsonschema.jsonschema`: %s", err)
+			}
+		}
+	case "validatesJSONPath":
+		if target := firstSyntheticsAssertionTarget(assertion, "targetjsonpath"); target != nil {
+			if path, _ := target["jsonpath"].(string); isSyntheticsValueResolved(path) {
+				if _, err := jp.ParseString(path); err != nil {
+					return fmt.Errorf("invalid `targetjsonpath.jsonpath` %q: %s", path, err)
+				}
+			}
+			if err := validateSyntheticsRegexTarget(target, "targetjsonpath.targetvalue"); err != nil {
+				return err
+			}
+		}
+	case "validatesXPath":
+		if target := firstSyntheticsAssertionTarget(assertion, "targetxpath"); target != nil {
+			if path, _ := target["xpath"].(string); isSyntheticsValueResolved(path) {
+				if _, err := xpath.Compile(path); err != nil {
+					return fmt.Errorf("invalid `targetxpath.xpath` %q: %s", path, err)
+				}
+			}
+			if err := validateSyntheticsRegexTarget(target, "targetxpath.targetvalue"); err != nil {
+				return err
+			}
+		}
+	default:
+		if value, ok := assertion["target"].(string); ok && isSyntheticsRegexOperator(operator) && isSyntheticsValueResolved(value) {
+			if err := validateSyntheticsRegex(value); err != nil {
+				return fmt.Errorf("invalid `target` regex %q: %s", value, err)
+			}
+		}
+		if scope, _ := assertion["timings_scope"].(string); scope != "" && assertionType != "responseTime" {
+			return fmt.Errorf("`timings_scope` is only valid for `responseTime` assertions, got `%s`", assertionType)
+		}
+	}
+	return nil
+}
+
+func firstSyntheticsAssertionTarget(assertion map[string]interface{}, key string) map[string]interface{} {
+	targets, ok := assertion[key].([]interface{})
+	if !ok || len(targets) == 0 || targets[0] == nil {
+		return nil
+	}
+	return targets[0].(map[string]interface{})
+}
+
+func isSyntheticsRegexOperator(operator string) bool {
+	return operator == "matches" || operator == "doesNotMatch"
+}
+
+func isSyntheticsValueResolved(value string) bool {
+	return value != "" && !syntheticsTemplateVariableRegex.MatchString(value)
+}
+
+func validateSyntheticsRegexTarget(target map[string]interface{}, name string) error {
+	operator, _ := target["operator"].(string)
+	value, _ := target["targetvalue"].(string)
+	if !isSyntheticsRegexOperator(operator) || !isSyntheticsValueResolved(value) {
+		return nil
+	}
+	if err := validateSyntheticsRegex(value); err != nil {
+		return fmt.Errorf("invalid `%s` regex %q: %s", name, value, err)
+	}
+	return nil
+}
+
+// validateSyntheticsRegex compiles the regex with JavaScript semantics, as assertions are evaluated by the
+// synthetics workers, which support constructs such as lookarounds that Go regexes don't.
+func validateSyntheticsRegex(value string) error {
+	_, err := regexp2.Compile(value, regexp2.ECMAScript)
+	return err
+}
+
+func validateSyntheticsJSONSchema(document, metaschema string) error {
+	if !isSyntheticsValueResolved(document) {
+		return nil
+	}
+	var parsed interface{}
+	if err := json.Unmarshal([]byte(document), &parsed); err != nil {
+		return fmt.Errorf("not valid JSON: %s", err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	if metaschema == "" {
+		metaschema = "draft-07"
+	}
+	draft, ok := syntheticsJSONSchemaDrafts[metaschema]
+	if !ok {
+		// Unsupported metaschemas are reported by the enum check of the builder
+		return nil
+	}
+	compiler.Draft = draft
+	// References to other documents are resolved by the synthetics workers, they're accepted as is offline
+	compiler.LoadURL = func(string) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader("{}")), nil
+	}
+	if err := compiler.AddResource("schema.json", strings.NewReader(document)); err != nil {
+		return err
+	}
+	if _, err := compiler.Compile("schema.json"); err != nil {
+		return err
+	}
+	return nil
+}
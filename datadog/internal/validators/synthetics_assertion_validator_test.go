@@ -0,0 +1,99 @@
+package validators
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateSyntheticsAssertion(t *testing.T) {
+	t.Parallel()
+
+	jsonPath := func(path, operator, value string) map[string]interface{} {
+		return map[string]interface{}{
+			"type":     "body",
+			"operator": "validatesJSONPath",
+			"targetjsonpath": []interface{}{map[string]interface{}{
+				"elementsoperator": "firstElementMatches",
+				"jsonpath":         path,
+				"operator":         operator,
+				"targetvalue":      value,
+			}},
+		}
+	}
+	xPath := func(path, operator, value string) map[string]interface{} {
+		return map[string]interface{}{
+			"type":     "body",
+			"operator": "validatesXPath",
+			"targetxpath": []interface{}{map[string]interface{}{
+				"xpath":       path,
+				"operator":    operator,
+				"targetvalue": value,
+			}},
+		}
+	}
+	jsonSchema := func(document, metaschema string) map[string]interface{} {
+		return map[string]interface{}{
+			"type":     "body",
+			"operator": "validatesJSONSchema",
+			"targetjsonschema": []interface{}{map[string]interface{}{
+				"jsonschema": document,
+				"metaschema": metaschema,
+			}},
+		}
+	}
+	target := func(assertionType, operator, value, timingsScope string) map[string]interface{} {
+		return map[string]interface{}{
+			"type":          assertionType,
+			"operator":      operator,
+			"target":        value,
+			"timings_scope": timingsScope,
+		}
+	}
+
+	testCases := map[string]struct {
+		assertion     map[string]interface{}
+		expectedError string
+	}{
+		"regex target":                      {assertion: target("statusCode", "matches", "20[04]", "")},
+		"javascript regex target":           {assertion: target("body", "doesNotMatch", `^(?!error).*$`, "")},
+		"invalid regex target":              {assertion: target("body", "matches", "20[04", ""), expectedError: "invalid `target` regex"},
+		"regex target with variable":        {assertion: target("body", "matches", "{{ PATTERN }}[", "")},
+		"non regex target":                  {assertion: target("body", "contains", "20[04", "")},
+		"timings scope":                     {assertion: target("responseTime", "lessThan", "2000", "withoutDNS")},
+		"timings scope on another type":     {assertion: target("statusCode", "is", "200", "withoutDNS"), expectedError: "`timings_scope` is only valid for `responseTime` assertions"},
+		"jsonpath":                          {assertion: jsonPath("$.items[?(@.price < 10)].name", "is", "book")},
+		"jsonpath without root":             {assertion: jsonPath("topKey", "isNot", "0")},
+		"invalid jsonpath":                  {assertion: jsonPath("$.items[?(@.price <", "is", "book"), expectedError: "invalid `targetjsonpath.jsonpath`"},
+		"jsonpath with variable":            {assertion: jsonPath("$.{{ KEY }}[", "is", "book")},
+		"jsonpath regex target value":       {assertion: jsonPath("$.name", "matches", "^b.*k$")},
+		"invalid jsonpath regex":            {assertion: jsonPath("$.name", "matches", "(book"), expectedError: "invalid `targetjsonpath.targetvalue` regex"},
+		"xpath":                             {assertion: xPath("/html/body/div[@class='title']/text()", "contains", "hello")},
+		"invalid xpath":                     {assertion: xPath("/html/body/div[@class='title'", "contains", "hello"), expectedError: "invalid `targetxpath.xpath`"},
+		"invalid xpath regex":               {assertion: xPath("//title", "doesNotMatch", "*hello"), expectedError: "invalid `targetxpath.targetvalue` regex"},
+		"unknown xpath":                     {assertion: xPath("", "contains", "hello")},
+		"jsonschema draft-07":               {assertion: jsonSchema(`{"type": "object", "properties": {"slideshow": {"type": "object"}}}`, "draft-07")},
+		"jsonschema draft-06":               {assertion: jsonSchema(`{"type": "array", "items": {"type": "string"}}`, "draft-06")},
+		"jsonschema with remote reference":  {assertion: jsonSchema(`{"$ref": "https://example.com/schema.json"}`, "draft-07")},
+		"jsonschema not json":               {assertion: jsonSchema(`{"type": "object"`, "draft-07"), expectedError: "not valid JSON"},
+		"jsonschema against its metaschema": {assertion: jsonSchema(`{"type": "objekt"}`, "draft-07"), expectedError: "invalid `targetjsonschema.jsonschema`"},
+		"jsonschema draft-07 keyword type":  {assertion: jsonSchema(`{"if": {"type": "string"}, "then": {"minLength": -1}}`, "draft-07"), expectedError: "invalid `targetjsonschema.jsonschema`"},
+		"javascript":                        {assertion: map[string]interface{}{"type": "javascript", "code": "dd.expect(1).to.equal(1);"}},
+	}
+
+	for name, test := range testCases {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			err := ValidateSyntheticsAssertion(test.assertion)
+			if test.expectedError == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.expectedError) {
+				t.Fatalf("expected error %q, got %v", test.expectedError, err)
+			}
+		})
+	}
+}
@@ -160,6 +160,17 @@ func ValidateNonEmptyStrings(v any, p cty.Path) diag.Diagnostics {
 	return diags
 }
 
+// ValidatePositiveDuration ensures a string is a positive duration such as `5m`
+func ValidatePositiveDuration(v interface{}, k string) (ws []string, errors []error) {
+	duration, err := time.ParseDuration(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid duration such as `1h`, got: %s", k, v))
+	} else if duration <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be a positive duration, got: %s", k, v))
+	}
+	return
+}
+
 // ValidateDatadogDowntimeRecurrenceType ensures a string is a valid recurrence type
 func ValidateDatadogDowntimeRecurrenceType(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
@@ -245,9 +245,7 @@ func Provider() *schema.Provider {
 			"datadog_security_monitoring_filter":           resourceDatadogSecurityMonitoringFilter(),
 			"datadog_sensitive_data_scanner_group":         resourceDatadogSensitiveDataScannerGroup(),
 			"datadog_sensitive_data_scanner_rule":          resourceDatadogSensitiveDataScannerRule(),
-			"datadog_service_level_objective":              resourceDatadogServiceLevelObjective(),
 			"datadog_service_definition_yaml":              resourceDatadogServiceDefinitionYAML(),
-			"datadog_slo_correction":                       resourceDatadogSloCorrection(),
 			"datadog_synthetics_test":                      resourceDatadogSyntheticsTest(),
 			"datadog_user":                                 resourceDatadogUser(),
 		},
@@ -257,6 +255,7 @@ func Provider() *schema.Provider {
 		DataSourcesMap: map[string]*schema.Resource{
 			"datadog_cloud_workload_security_agent_rules":     dataSourceDatadogCloudWorkloadSecurityAgentRules(),
 			"datadog_dashboard":                               dataSourceDatadogDashboard(),
+			"datadog_graph_snapshot":                          dataSourceDatadogGraphSnapshot(),
 			"datadog_integration_aws_logs_services":           dataSourceDatadogIntegrationAWSLogsServices(),
 			"datadog_logs_archives_order":                     dataSourceDatadogLogsArchivesOrder(),
 			"datadog_logs_indexes":                            dataSourceDatadogLogsIndexes(),
@@ -2,9 +2,14 @@ package datadog
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
 
 	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
 	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
@@ -25,7 +30,15 @@ func resourceDatadogDashboard() *schema.Resource {
 		UpdateContext: resourceDatadogDashboardUpdate,
 		ReadContext:   resourceDatadogDashboardRead,
 		DeleteContext: resourceDatadogDashboardDelete,
-		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			powerpacks, err := validateDashboardPowerpacks(ctx, diff, meta)
+			if err != nil {
+				return err
+			}
+			if err := planDashboardPowerpackChecksums(diff, powerpacks); err != nil {
+				return err
+			}
+
 			oldValue, newValue := diff.GetChange("dashboard_lists")
 			if !oldValue.(*schema.Set).Equal(newValue.(*schema.Set)) {
 				// Only calculate removed when the list change, to no create useless diffs
@@ -130,6 +143,12 @@ func resourceDatadogDashboard() *schema.Resource {
 					Description: "A list of dashboard lists this dashboard should be removed from. Internal only.",
 					Elem:        &schema.Schema{Type: schema.TypeInt},
 				},
+				"powerpack_checksums": {
+					Type:        schema.TypeMap,
+					Computed:    true,
+					Description: "A map of the IDs of the powerpacks used by this dashboard to a checksum of their definition, used to warn when a powerpack is modified outside of this dashboard. Internal only.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
 				"is_read_only": {
 					Type:          schema.TypeBool,
 					Optional:      true,
@@ -191,7 +210,11 @@ func resourceDatadogDashboardCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	return updateDashboardState(d, &getDashboard)
+	diags := updateDashboardState(d, &getDashboard)
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, storeDashboardPowerpackChecksums(d, providerConf, &getDashboard)...)
 }
 
 func resourceDatadogDashboardUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -213,7 +236,11 @@ func resourceDatadogDashboardUpdate(ctx context.Context, d *schema.ResourceData,
 
 	updateDashboardLists(d, providerConf, *dashboard.Id, d.Get("layout_type").(string))
 
-	return updateDashboardState(d, &updatedDashboard)
+	diags := updateDashboardState(d, &updatedDashboard)
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, storeDashboardPowerpackChecksums(d, providerConf, &updatedDashboard)...)
 }
 
 func updateDashboardLists(d *schema.ResourceData, providerConf *ProviderConfiguration, dashboardID string, layoutType string) {
@@ -330,7 +357,11 @@ func resourceDatadogDashboardRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	return updateDashboardState(d, &dashboard)
+	diags := updateDashboardState(d, &dashboard)
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, refreshDashboardPowerpackChecksums(d, providerConf, &dashboard)...)
 }
 
 func resourceDatadogDashboardDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -8579,6 +8610,226 @@ func getPowerpackDefinitionSchema() map[string]*schema.Schema {
 	}
 }
 
+// getTerraformPowerpackDefinitions returns the `powerpack_definition` of the given widgets, including the ones nested in group widgets.
+func getTerraformPowerpackDefinitions(terraformWidgets []interface{}) []map[string]interface{} {
+	var definitions []map[string]interface{}
+	for _, w := range terraformWidgets {
+		terraformWidget, ok := w.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if def, ok := terraformWidget["powerpack_definition"].([]interface{}); ok && len(def) > 0 {
+			if powerpackDefinition, ok := def[0].(map[string]interface{}); ok {
+				definitions = append(definitions, powerpackDefinition)
+			}
+		}
+		if def, ok := terraformWidget["group_definition"].([]interface{}); ok && len(def) > 0 {
+			if groupDefinition, ok := def[0].(map[string]interface{}); ok {
+				if groupWidgets, ok := groupDefinition["widget"].([]interface{}); ok {
+					definitions = append(definitions, getTerraformPowerpackDefinitions(groupWidgets)...)
+				}
+			}
+		}
+	}
+	return definitions
+}
+
+// getDatadogPowerpackIDs returns the IDs of the powerpacks used by the given widgets, including the ones nested in group widgets.
+func getDatadogPowerpackIDs(widgets []datadogV1.Widget) []string {
+	var ids []string
+	for _, widget := range widgets {
+		if widget.Definition.PowerpackWidgetDefinition != nil {
+			ids = append(ids, widget.Definition.PowerpackWidgetDefinition.GetPowerpackId())
+		}
+		if widget.Definition.GroupWidgetDefinition != nil {
+			ids = append(ids, getDatadogPowerpackIDs(widget.Definition.GroupWidgetDefinition.GetWidgets())...)
+		}
+	}
+	return ids
+}
+
+// validateDashboardPowerpacks resolves the powerpacks referenced by `powerpack_definition` widgets and checks
+// that the template variables set on each widget are declared by the powerpack. It returns the resolved powerpacks.
+func validateDashboardPowerpacks(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (map[string]datadogV2.PowerpackAttributes, error) {
+	powerpacks := make(map[string]datadogV2.PowerpackAttributes)
+	definitions := getTerraformPowerpackDefinitions(diff.Get("widget").([]interface{}))
+	if len(definitions) == 0 {
+		return powerpacks, nil
+	}
+
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.Auth
+
+	for _, definition := range definitions {
+		powerpackID, _ := definition["powerpack_id"].(string)
+		if powerpackID == "" {
+			// The powerpack ID is not known until apply
+			continue
+		}
+
+		powerpack, ok := powerpacks[powerpackID]
+		if !ok {
+			resp, httpresp, err := apiInstances.GetPowerpackApiV2().GetPowerpack(auth, powerpackID)
+			if err != nil {
+				if httpresp != nil && httpresp.StatusCode == 404 {
+					return nil, fmt.Errorf("powerpack %s used by a `powerpack_definition` widget does not exist", powerpackID)
+				}
+				return nil, utils.TranslateClientError(err, httpresp, "error getting powerpack")
+			}
+			data := resp.GetData()
+			powerpack = data.GetAttributes()
+			powerpacks[powerpackID] = powerpack
+		}
+
+		declared := make(map[string]bool)
+		var declaredNames []string
+		for _, templateVariable := range powerpack.GetTemplateVariables() {
+			declared[templateVariable.GetName()] = true
+			declaredNames = append(declaredNames, templateVariable.GetName())
+		}
+
+		templateVariables, ok := definition["template_variables"].([]interface{})
+		if !ok || len(templateVariables) == 0 || templateVariables[0] == nil {
+			continue
+		}
+		tvars := templateVariables[0].(map[string]interface{})
+		for _, kind := range []string{"controlled_externally", "controlled_by_powerpack"} {
+			contents, _ := tvars[kind].([]interface{})
+			for _, content := range contents {
+				name, _ := content.(map[string]interface{})["name"].(string)
+				if name == "" || declared[name] {
+					continue
+				}
+				return nil, fmt.Errorf("template variable `%s` set in `%s` of the widget using powerpack %s is not declared by the powerpack, declared template variables are: %s", name, kind, powerpackID, strings.Join(declaredNames, ", "))
+			}
+		}
+	}
+
+	return powerpacks, nil
+}
+
+// dashboardPowerpackChecksum returns a checksum of the definition of a powerpack.
+func dashboardPowerpackChecksum(powerpack datadogV2.PowerpackAttributes) (string, error) {
+	definition, err := json.Marshal(powerpack)
+	if err != nil {
+		return "", err
+	}
+	return utils.ConvertToSha256(string(definition)), nil
+}
+
+// planDashboardPowerpackChecksums plans the checksums of the powerpacks used by the dashboard from the powerpacks
+// resolved by validateDashboardPowerpacks. They are left unknown when some powerpack IDs are only known at apply.
+func planDashboardPowerpackChecksums(diff *schema.ResourceDiff, powerpacks map[string]datadogV2.PowerpackAttributes) error {
+	checksums := make(map[string]interface{})
+	for _, definition := range getTerraformPowerpackDefinitions(diff.Get("widget").([]interface{})) {
+		powerpackID, _ := definition["powerpack_id"].(string)
+		if powerpackID == "" {
+			return diff.SetNewComputed("powerpack_checksums")
+		}
+		checksum, err := dashboardPowerpackChecksum(powerpacks[powerpackID])
+		if err != nil {
+			return err
+		}
+		checksums[powerpackID] = checksum
+	}
+
+	if reflect.DeepEqual(checksums, diff.Get("powerpack_checksums").(map[string]interface{})) {
+		return nil
+	}
+	return diff.SetNew("powerpack_checksums", checksums)
+}
+
+// storeDashboardPowerpackChecksums stores the planned checksums of the powerpacks used by the dashboard, getting
+// the powerpacks whose checksum couldn't be planned. Planned changes never warn about modified powerpacks.
+func storeDashboardPowerpackChecksums(d *schema.ResourceData, providerConf *ProviderConfiguration, dashboard *datadogV1.Dashboard) diag.Diagnostics {
+	planned := d.Get("powerpack_checksums").(map[string]interface{})
+	checksums := make(map[string]interface{})
+	var missing []string
+	for _, powerpackID := range getDatadogPowerpackIDs(dashboard.GetWidgets()) {
+		if checksum, ok := planned[powerpackID]; ok {
+			checksums[powerpackID] = checksum
+		} else if powerpackID != "" {
+			missing = append(missing, powerpackID)
+		}
+	}
+
+	fetched, diags := getDashboardPowerpackChecksums(providerConf, missing)
+	for powerpackID, checksum := range fetched {
+		checksums[powerpackID] = checksum
+	}
+	if err := d.Set("powerpack_checksums", checksums); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
+
+// refreshDashboardPowerpackChecksums compares the checksums of the powerpacks used by the dashboard with the stored
+// ones, and warns about the powerpacks modified since the dashboard was last applied or refreshed.
+func refreshDashboardPowerpackChecksums(d *schema.ResourceData, providerConf *ProviderConfiguration, dashboard *datadogV1.Dashboard) diag.Diagnostics {
+	previousChecksums := d.Get("powerpack_checksums").(map[string]interface{})
+	checksums, diags := getDashboardPowerpackChecksums(providerConf, getDatadogPowerpackIDs(dashboard.GetWidgets()))
+	if diags.HasError() {
+		return diags
+	}
+
+	powerpackIDs := make([]string, 0, len(checksums))
+	for powerpackID := range checksums {
+		powerpackIDs = append(powerpackIDs, powerpackID)
+	}
+	sort.Strings(powerpackIDs)
+	for _, powerpackID := range powerpackIDs {
+		if previous, ok := previousChecksums[powerpackID].(string); ok && previous != checksums[powerpackID] {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("powerpack %s used by this dashboard was modified", powerpackID),
+				Detail:   "The powerpack definition changed outside of this dashboard since it was last applied or refreshed. Check that the `template_variables` of the `powerpack_definition` widgets using it are still valid.",
+			})
+		}
+	}
+
+	// Powerpacks which couldn't be read keep their previous checksum
+	for powerpackID, checksum := range previousChecksums {
+		if _, ok := checksums[powerpackID]; !ok && slices.Contains(getDatadogPowerpackIDs(dashboard.GetWidgets()), powerpackID) {
+			checksums[powerpackID] = checksum
+		}
+	}
+	if err := d.Set("powerpack_checksums", checksums); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
+
+// getDashboardPowerpackChecksums gets the checksums of powerpacks, warning about the powerpacks which can't be read.
+func getDashboardPowerpackChecksums(providerConf *ProviderConfiguration, powerpackIDs []string) (map[string]interface{}, diag.Diagnostics) {
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.Auth
+
+	diags := diag.Diagnostics{}
+	checksums := make(map[string]interface{})
+	for _, powerpackID := range powerpackIDs {
+		if _, ok := checksums[powerpackID]; ok || powerpackID == "" {
+			continue
+		}
+		resp, httpresp, err := apiInstances.GetPowerpackApiV2().GetPowerpack(auth, powerpackID)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("could not get powerpack %s used by this dashboard", powerpackID),
+				Detail:   utils.TranslateClientError(err, httpresp, "").Error(),
+			})
+			continue
+		}
+		data := resp.GetData()
+		checksum, err := dashboardPowerpackChecksum(data.GetAttributes())
+		if err != nil {
+			return checksums, append(diags, diag.FromErr(err)...)
+		}
+		checksums[powerpackID] = checksum
+	}
+	return checksums, diags
+}
+
 //
 // Split Graph Definition helpers
 //
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/rrule"
 	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
 	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
 
@@ -398,9 +399,10 @@ func resourceDatadogMonitor() *schema.Resource {
 											Elem: &schema.Resource{
 												Schema: map[string]*schema.Schema{
 													"rrule": {
-														Description: "Must be a valid `rrule`. See API docs for supported fields",
-														Type:        schema.TypeString,
-														Required:    true,
+														Description:      "Must be a valid `rrule`. See API docs for supported fields. " + rrule.MonitorSchedules.Description(),
+														Type:             schema.TypeString,
+														Required:         true,
+														ValidateDiagFunc: validators.ValidateRRule(rrule.MonitorSchedules),
 													},
 													"start": {
 														Description: "Time to start recurrence cycle. Similar to DTSTART. Expected format 'YYYY-MM-DDThh:mm:ss'",
@@ -408,9 +410,16 @@ func resourceDatadogMonitor() *schema.Resource {
 														Optional:    true,
 													},
 													"timezone": {
-														Description: "'tz database' format. Example: `America/New_York` or `UTC`",
-														Type:        schema.TypeString,
-														Required:    true,
+														Description:      "'tz database' format. Example: `America/New_York` or `UTC`",
+														Type:             schema.TypeString,
+														Required:         true,
+														ValidateDiagFunc: validators.ValidateRRuleTimezone,
+													},
+													"next_occurrences": {
+														Description: fmt.Sprintf("The next %d evaluations of the monitor according to the custom schedule, in RFC 3339 format in the schedule's `timezone`. When `start` is omitted, the schedule starts when the monitor is created.", monitorCustomScheduleNextOccurrences),
+														Type:        schema.TypeList,
+														Computed:    true,
+														Elem:        &schema.Schema{Type: schema.TypeString},
 													},
 												},
 											},
@@ -1007,6 +1016,34 @@ func resourceDatadogMonitorCreate(ctx context.Context, d *schema.ResourceData, m
 	return updateMonitorState(d, meta, &mCreated)
 }
 
+// Number of evaluations previewed in `next_occurrences` of custom schedules.
+const monitorCustomScheduleNextOccurrences = 5
+
+// monitorCustomScheduleOccurrences previews the next evaluations of a custom schedule, which starts when the monitor
+// was created unless its start is set.
+func monitorCustomScheduleOccurrences(r datadogV1.MonitorOptionsCustomScheduleRecurrence, created time.Time, now time.Time) []string {
+	occurrences := []string{}
+	rule, err := rrule.MonitorSchedules.Parse(r.GetRrule())
+	if err != nil {
+		return occurrences
+	}
+	// Timezones which aren't part of the tz database are reported by the validation of `timezone`
+	loc, err := rrule.Location(r.GetTimezone())
+	if err != nil {
+		return occurrences
+	}
+	dtstart := created.In(loc)
+	if start, ok := r.GetStartOk(); ok && *start != "" {
+		if dtstart, err = time.ParseInLocation("2006-01-02T15:04:05", *start, loc); err != nil {
+			return occurrences
+		}
+	}
+	for _, o := range rule.Occurrences(dtstart, now, monitorCustomScheduleNextOccurrences) {
+		occurrences = append(occurrences, o.Format(time.RFC3339))
+	}
+	return occurrences
+}
+
 func updateMonitorState(d *schema.ResourceData, meta interface{}, m *datadogV1.Monitor) diag.Diagnostics {
 	thresholds := make(map[string]string)
 
@@ -1213,6 +1250,7 @@ func updateMonitorState(d *schema.ResourceData, meta interface{}, m *datadogV1.M
 			if timezone, ok := r.GetTimezoneOk(); ok {
 				recurrence["timezone"] = timezone
 			}
+			recurrence["next_occurrences"] = monitorCustomScheduleOccurrences(r, m.GetCreated(), meta.(*ProviderConfiguration).Now())
 			value := [](interface{}){recurrence}
 			custom_schedule["recurrence"] = value
 		}
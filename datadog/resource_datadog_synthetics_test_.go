@@ -10,11 +10,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log"
 	"maps"
 	_nethttp "net/http"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
 	"github.com/hashicorp/go-cty/cty"
@@ -153,6 +156,7 @@ func resourceDatadogSyntheticsTest() *schema.Resource {
 					Type:        schema.TypeBool,
 					Optional:    true,
 				},
+				"trigger_on_apply": syntheticsTestTriggerOnApply(),
 			}
 		},
 	}
@@ -1460,7 +1464,7 @@ func syntheticsBrowserStepParams() schema.Schema {
 					Optional:    true,
 				},
 				"subtest_public_id": {
-					Description: "ID of the Synthetics test to use as subtest.",
+					Description: "ID of the Synthetics test to use as subtest. The subtest is checked at plan time: it must be a browser test, the variables it uses must be defined by it or by the tests playing it, and subtests can't be nested more than two levels deep nor play each other.",
 					Type:        schema.TypeString,
 					Optional:    true,
 				},
@@ -1804,6 +1808,39 @@ func syntheticsBrowserVariableElem() *schema.Resource {
 	}
 }
 
+func syntheticsTestTriggerOnApply() *schema.Schema {
+	return &schema.Schema{
+		Description: "Trigger the test with the synthetics CI trigger endpoint after it's created or updated, and fail the apply if it fails. Failing results of a newly created test taint the resource.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"wait_for_result": {
+					Description: "Whether to wait for the results of the triggered test. When `false`, the test is triggered without checking its results.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+				},
+				"timeout": {
+					Description:  "How long to wait for the results of the triggered test, as a duration such as `30s` or `5m`.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "5m",
+					ValidateFunc: validators.ValidatePositiveDuration,
+				},
+				"fail_on": {
+					Description:  "Which failing results fail the apply. `blocking` only considers the results with a `blocking` CI execution rule, `any` considers all the failing results, and `none` reports the failing results as warnings.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "blocking",
+					ValidateFunc: validation.StringInSlice([]string{"blocking", "any", "none"}, false),
+				},
+			},
+		},
+	}
+}
+
 func syntheticsConfigVariable() *schema.Schema {
 	return &schema.Schema{
 		Description: "Variables used for the test configuration. Multiple `config_variable` blocks are allowed with the structure below.",
@@ -1879,7 +1916,42 @@ func syntheticsHttpVersionOption() *schema.Schema {
  * CRUD functions
  */
 
+// SyntheticsTestResource returns the `datadog_synthetics_test` resource. Its schema, builders and CRUD functions
+// back the typed synthetics test resources of the framework provider.
+func SyntheticsTestResource() *schema.Resource {
+	return resourceDatadogSyntheticsTest()
+}
+
+// ValidateSyntheticsTest runs the plan-time checks of the `datadog_synthetics_test` resource against a
+// ResourceData, for the typed synthetics test resources of the framework provider.
+func ValidateSyntheticsTest(d *schema.ResourceData) error {
+	return validateSyntheticsTest(d)
+}
+
+// syntheticsTestGetter is implemented by both schema.ResourceDiff and schema.ResourceData.
+type syntheticsTestGetter interface {
+	Get(string) interface{}
+	GetOk(string) (interface{}, bool)
+}
+
 func resourceDatadogSyntheticsTestCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := validateSyntheticsTest(diff); err != nil {
+		return err
+	}
+	// Subtests are only resolved when the steps or the variables they can use change, to avoid reading them at every plan
+	if diff.Id() != "" && !diff.HasChanges("browser_step", "browser_variable", "config_variable") {
+		return nil
+	}
+	return validateSyntheticsTestSubtests(diff.Id(), diff, meta)
+}
+
+// ValidateSyntheticsTestSubtests resolves the subtests played by the steps of a browser test, see
+// validateSyntheticsTestSubtests.
+func ValidateSyntheticsTestSubtests(d *schema.ResourceData, meta interface{}) error {
+	return validateSyntheticsTestSubtests(d.Id(), d, meta)
+}
+
+func validateSyntheticsTest(diff syntheticsTestGetter) error {
 	// validate locations are not empty for API and browser tests
 	type_, typeOk := diff.GetOk("type")
 	if typeOk && (type_.(string) == string(datadogV1.SYNTHETICSTESTDETAILSTYPE_API) || type_.(string) == string(datadogV1.SYNTHETICSTESTDETAILSTYPE_BROWSER)) {
@@ -1890,6 +1962,46 @@ func resourceDatadogSyntheticsTestCustomizeDiff(ctx context.Context, diff *schem
 		}
 	}
 
+	if err := validateSyntheticsTestAssertions(diff); err != nil {
+		return err
+	}
+
+	return validateSyntheticsTestGRPCMessage(diff)
+}
+
+// validateSyntheticsTestAssertions checks the regexes, JSONPath and XPath expressions and JSON Schema documents of
+// the assertions, which are otherwise only rejected by the API at apply time.
+func validateSyntheticsTestAssertions(diff syntheticsTestGetter) error {
+	if assertions, ok := diff.Get("assertion").([]interface{}); ok {
+		for i, assertion := range assertions {
+			if assertion == nil {
+				continue
+			}
+			if err := validators.ValidateSyntheticsAssertion(assertion.(map[string]interface{})); err != nil {
+				return fmt.Errorf("assertion.%d: %s", i, err)
+			}
+		}
+	}
+	if steps, ok := diff.Get("api_step").([]interface{}); ok {
+		for i, step := range steps {
+			if step == nil {
+				continue
+			}
+			assertions, _ := step.(map[string]interface{})["assertion"].([]interface{})
+			for j, assertion := range assertions {
+				if assertion == nil {
+					continue
+				}
+				if err := validators.ValidateSyntheticsAssertion(assertion.(map[string]interface{})); err != nil {
+					return fmt.Errorf("api_step.%d.assertion.%d: %s", i, j, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func validateSyntheticsTestGRPCMessage(diff syntheticsTestGetter) error {
 	// Validate gRPC message requirements during planning
 	subtype, subtypeOk := diff.GetOk("subtype")
 	if !subtypeOk || subtype.(string) != "grpc" {
@@ -1909,7 +2021,165 @@ func resourceDatadogSyntheticsTestCustomizeDiff(ctx context.Context, diff *schem
 	return nil
 }
 
+// syntheticsMaxSubtestDepth is the number of levels of subtests the API allows a browser test to play
+const syntheticsMaxSubtestDepth = 2
+
+// Variables are referenced as `{{ NAME }}` in the steps. Variable names are upper case, which sets them apart from
+// the built-in variables such as `{{ uuid }}` or `{{ numeric(6) }}`.
+var syntheticsVariableUsageRegex = regexp.MustCompile(`{{\s*([A-Z][A-Z0-9_]*)\s*}}`)
+
+// validateSyntheticsTestSubtests fetches the tests played by the `playSubTest` steps of a browser test, and checks
+// that they are browser tests, that the variables they use are defined and that they don't play each other in a
+// cycle or nest too deeply. Subtests referencing tests that are not created yet are checked at the next plan, and
+// subtests which can't be read, for instance because they belong to another organization, are skipped.
+func validateSyntheticsTestSubtests(id string, diff syntheticsTestGetter, meta interface{}) error {
+	providerConf, ok := meta.(*ProviderConfiguration)
+	if !ok || diff.Get("type").(string) != string(datadogV1.SYNTHETICSTESTDETAILSTYPE_BROWSER) {
+		return nil
+	}
+
+	defined := map[string]bool{}
+	for _, key := range []string{"browser_variable", "config_variable"} {
+		variables, _ := diff.Get(key).([]interface{})
+		for _, variable := range variables {
+			if variable != nil {
+				defined[variable.(map[string]interface{})["name"].(string)] = true
+			}
+		}
+	}
+
+	resolver := &syntheticsSubtestResolver{
+		api:   providerConf.DatadogApiInstances.GetSyntheticsApiV1(),
+		auth:  providerConf.Auth,
+		tests: map[string]*datadogV1.SyntheticsTestDetails{},
+	}
+	steps, _ := diff.Get("browser_step").([]interface{})
+	for i, step := range steps {
+		if step == nil {
+			continue
+		}
+		params, _ := step.(map[string]interface{})["params"].([]interface{})
+		if len(params) == 0 || params[0] == nil {
+			continue
+		}
+		stepParams := params[0].(map[string]interface{})
+		if subtestID, _ := stepParams["subtest_public_id"].(string); subtestID != "" && step.(map[string]interface{})["type"] == string(datadogV1.SYNTHETICSSTEPTYPE_PLAY_SUB_TEST) {
+			if err := resolver.resolve(subtestID, []string{id}, defined); err != nil {
+				return fmt.Errorf("browser_step.%d: %s", i, err)
+			}
+		}
+		if variables, _ := stepParams["variable"].([]interface{}); len(variables) > 0 && variables[0] != nil {
+			defined[variables[0].(map[string]interface{})["name"].(string)] = true
+		}
+	}
+	return nil
+}
+
+type syntheticsSubtestResolver struct {
+	api   *datadogV1.SyntheticsApi
+	auth  context.Context
+	tests map[string]*datadogV1.SyntheticsTestDetails
+}
+
+func (r *syntheticsSubtestResolver) get(id string) (*datadogV1.SyntheticsTestDetails, error) {
+	if test, ok := r.tests[id]; ok {
+		return test, nil
+	}
+	test, httpResp, err := r.api.GetTest(r.auth, id)
+	if err != nil {
+		// The subtest may belong to another organization, or be deleted and recreated in the same apply: leave it
+		// for the API to check instead of failing the plan.
+		if httpResp != nil && (httpResp.StatusCode == 403 || httpResp.StatusCode == 404) {
+			log.Printf("[WARN] subtest %s can't be read (HTTP %d), skipping its validation", id, httpResp.StatusCode)
+			r.tests[id] = nil
+			return nil, nil
+		}
+		return nil, utils.TranslateClientError(err, httpResp, "error getting subtest "+id)
+	}
+	r.tests[id] = &test
+	return &test, nil
+}
+
+// resolve checks the subtest with the given ID, played by the tests of the chain with the variables defined by them.
+func (r *syntheticsSubtestResolver) resolve(id string, chain []string, defined map[string]bool) error {
+	path := strings.Join(append(chain, id), " -> ")
+	if chain[0] == "" {
+		path = "this test" + path
+	}
+	for _, parent := range chain {
+		if parent == id {
+			return fmt.Errorf("subtest %s plays itself: %s", id, path)
+		}
+	}
+	if len(chain) > syntheticsMaxSubtestDepth {
+		return fmt.Errorf("subtests can only be nested %d levels deep: %s", syntheticsMaxSubtestDepth, path)
+	}
+
+	test, err := r.get(id)
+	if err != nil || test == nil {
+		return err
+	}
+	if test.GetType() != datadogV1.SYNTHETICSTESTDETAILSTYPE_BROWSER {
+		return fmt.Errorf("subtest %s is a %s test, only browser tests can be played as subtests", id, test.GetType())
+	}
+
+	// The subtest can use its own variables and the ones of the tests playing it
+	available := maps.Clone(defined)
+	config := test.GetConfig()
+	for _, variable := range config.GetVariables() {
+		available[variable.GetName()] = true
+	}
+	for _, variable := range config.GetConfigVariables() {
+		available[variable.GetName()] = true
+	}
+
+	missing := map[string]bool{}
+	request := config.GetRequest()
+	syntheticsMissingVariables(request.GetUrl(), available, missing)
+	for _, step := range test.GetSteps() {
+		stepParams, _ := step.GetParams().(map[string]interface{})
+		params, _ := json.Marshal(stepParams)
+		syntheticsMissingVariables(string(params), available, missing)
+
+		if subtestID, _ := stepParams["subtestPublicId"].(string); subtestID != "" && step.GetType() == datadogV1.SYNTHETICSSTEPTYPE_PLAY_SUB_TEST {
+			if err := r.resolve(subtestID, append(chain, id), available); err != nil {
+				return err
+			}
+		}
+		if variable, ok := stepParams["variable"].(map[string]interface{}); ok {
+			if name, _ := variable["name"].(string); name != "" {
+				available[name] = true
+			}
+		}
+	}
+	if len(missing) > 0 {
+		names := make([]string, 0, len(missing))
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("subtest %s uses variables that are not defined by it or by the tests playing it: %s", id, strings.Join(names, ", "))
+	}
+	return nil
+}
+
+func syntheticsMissingVariables(value string, available map[string]bool, missing map[string]bool) {
+	for _, match := range syntheticsVariableUsageRegex.FindAllStringSubmatch(value, -1) {
+		if !available[match[1]] {
+			missing[match[1]] = true
+		}
+	}
+}
+
 func resourceDatadogSyntheticsTestCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := createSyntheticsTest(ctx, d, meta)
+	if diags.HasError() || d.Id() == "" {
+		return diags
+	}
+	return append(diags, triggerSyntheticsTestOnApply(ctx, d, meta)...)
+}
+
+func createSyntheticsTest(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	diags := diag.Diagnostics{}
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
@@ -2105,6 +2375,14 @@ func resourceDatadogSyntheticsTestRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceDatadogSyntheticsTestUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := updateSyntheticsTest(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, triggerSyntheticsTestOnApply(ctx, d, meta)...)
+}
+
+func updateSyntheticsTest(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	diags := diag.Diagnostics{}
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
@@ -2186,6 +2464,137 @@ func resourceDatadogSyntheticsTestDelete(ctx context.Context, d *schema.Resource
 	return nil
 }
 
+// triggerSyntheticsTestOnApply runs the test with the CI trigger endpoint when `trigger_on_apply` is set, and waits
+// for its results.
+func triggerSyntheticsTestOnApply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	triggers := d.Get("trigger_on_apply").([]interface{})
+	if len(triggers) == 0 || triggers[0] == nil {
+		return nil
+	}
+	trigger := triggers[0].(map[string]interface{})
+
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.Auth
+
+	body := datadogV1.NewSyntheticsCITestBody()
+	body.SetTests([]datadogV1.SyntheticsCITest{*datadogV1.NewSyntheticsCITest(d.Id())})
+	triggered, httpResponse, err := apiInstances.GetSyntheticsApiV1().TriggerCITests(auth, *body)
+	if err != nil {
+		return utils.TranslateClientErrorDiag(err, httpResponse, "error triggering synthetics test")
+	}
+	if err := utils.CheckForUnparsed(triggered); err != nil {
+		return diag.FromErr(err)
+	}
+	if !trigger["wait_for_result"].(bool) {
+		return nil
+	}
+	batchId := triggered.GetBatchId()
+	if batchId == "" {
+		return diag.Errorf("no batch was created when triggering synthetics test %s", d.Id())
+	}
+
+	timeout, _ := time.ParseDuration(trigger["timeout"].(string))
+	var batch datadogV1.SyntheticsBatchDetailsData
+	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		batchResponse, httpResponse, err := apiInstances.GetSyntheticsApiV1().GetSyntheticsCIBatch(auth, batchId)
+		if err != nil {
+			if httpResponse != nil && httpResponse.StatusCode == 404 {
+				return retry.RetryableError(fmt.Errorf("synthetics batch %s not created yet", batchId))
+			}
+			return retry.NonRetryableError(utils.TranslateClientError(err, httpResponse, "error getting synthetics batch"))
+		}
+		batch = batchResponse.GetData()
+		// Batches in progress don't have one of the final batch statuses
+		if _, ok := batch.GetStatusOk(); !ok {
+			return retry.RetryableError(fmt.Errorf("synthetics batch %s still in progress", batchId))
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("error waiting for the results of synthetics test %s: %s", d.Id(), err)
+	}
+
+	diags := diag.Diagnostics{}
+	failOn := trigger["fail_on"].(string)
+	for _, result := range batch.GetResults() {
+		if result.GetStatus() != datadogV1.SYNTHETICSBATCHSTATUS_FAILED {
+			continue
+		}
+		severity := diag.Error
+		// Results without an execution rule are blocking
+		if executionRule, ok := result.GetExecutionRuleOk(); failOn == "none" || (failOn == "blocking" && ok && *executionRule != datadogV1.SYNTHETICSTESTEXECUTIONRULE_BLOCKING) {
+			severity = diag.Warning
+		}
+		location := result.GetLocation()
+		if device, ok := result.GetDeviceOk(); ok {
+			location = fmt.Sprintf("%s (%s)", location, *device)
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: severity,
+			Summary:  fmt.Sprintf("synthetics test %s failed on %s", d.Id(), location),
+			Detail:   getSyntheticsTestResultFailure(apiInstances, auth, result),
+		})
+	}
+	return diags
+}
+
+// getSyntheticsTestResultFailure describes why a result of a triggered test failed.
+func getSyntheticsTestResultFailure(apiInstances *utils.ApiInstances, auth context.Context, result datadogV1.SyntheticsBatchResult) string {
+	details := []string{fmt.Sprintf("Result ID: %s", result.GetResultId())}
+	switch result.GetTestType() {
+	case datadogV1.SYNTHETICSTESTDETAILSTYPE_API:
+		fullResult, httpResponse, err := apiInstances.GetSyntheticsApiV1().GetAPITestResult(auth, result.GetTestPublicId(), result.GetResultId())
+		if err != nil {
+			return strings.Join(append(details, utils.TranslateClientError(err, httpResponse, "error getting synthetics API test result").Error()), "\n")
+		}
+		data := fullResult.GetResult()
+		if failure, ok := data.GetFailureOk(); ok {
+			details = append(details, fmt.Sprintf("%s: %s", failure.GetCode(), failure.GetMessage()))
+		}
+		details = append(details, getSyntheticsTestFailedAssertions(data.AdditionalProperties["assertionResults"])...)
+	case datadogV1.SYNTHETICSTESTDETAILSTYPE_BROWSER:
+		fullResult, httpResponse, err := apiInstances.GetSyntheticsApiV1().GetBrowserTestResult(auth, result.GetTestPublicId(), result.GetResultId())
+		if err != nil {
+			return strings.Join(append(details, utils.TranslateClientError(err, httpResponse, "error getting synthetics browser test result").Error()), "\n")
+		}
+		data := fullResult.GetResult()
+		if failure, ok := data.GetFailureOk(); ok {
+			details = append(details, fmt.Sprintf("%s: %s", failure.GetCode(), failure.GetMessage()))
+		} else if errorMessage, ok := data.GetErrorOk(); ok {
+			details = append(details, *errorMessage)
+		}
+		for _, step := range data.GetStepDetails() {
+			if errorMessage, ok := step.GetErrorOk(); ok && !step.GetAllowFailure() {
+				details = append(details, fmt.Sprintf("step %q: %s", step.GetDescription(), *errorMessage))
+			}
+		}
+	}
+	return strings.Join(details, "\n")
+}
+
+// getSyntheticsTestFailedAssertions describes the failed assertions of an API test result. They aren't part of the
+// result model of the API client.
+func getSyntheticsTestFailedAssertions(assertionResults interface{}) []string {
+	failedAssertions := []string{}
+	results, _ := assertionResults.([]interface{})
+	for _, result := range results {
+		assertion, ok := result.(map[string]interface{})
+		if !ok || assertion["valid"] != false {
+			continue
+		}
+		description := fmt.Sprintf("assertion failed: %v %v %v", assertion["type"], assertion["operator"], assertion["target"])
+		if property, ok := assertion["property"]; ok {
+			description = fmt.Sprintf("assertion failed: %v %v %v %v", assertion["type"], property, assertion["operator"], assertion["target"])
+		}
+		if actual, ok := assertion["actual"]; ok {
+			description = fmt.Sprintf("%s, got %v", description, actual)
+		}
+		failedAssertions = append(failedAssertions, description)
+	}
+	return failedAssertions
+}
+
 func updateSyntheticsBrowserTestLocalState(d *schema.ResourceData, syntheticsTest *datadogV1.SyntheticsBrowserTest) diag.Diagnostics {
 	if err := d.Set("type", syntheticsTest.GetType()); err != nil {
 		return diag.FromErr(err)
@@ -2343,46 +2752,17 @@ func updateSyntheticsBrowserTestLocalState(d *schema.ResourceData, syntheticsTes
 		params := step.GetParams()
 		paramsMap := params.(map[string]interface{})
 
-		for key, value := range paramsMap {
-			if key == "element" && forceElementUpdate == true {
-				// prevent overriding `element` in the local state with the one received from the backend, and
-				// keep the element from the local state instead
-				element := d.Get(fmt.Sprintf("browser_step.%d.params.0.element", stepIndex))
-				localParams["element"] = element
-			} else if key == "files" {
-				// prevent overriding `files` in the local state with the one received from the backend, and
-				// keep the files from the local state instead
-				files := d.Get(fmt.Sprintf("browser_step.%d.params.0.files", stepIndex))
-				localParams["files"] = files
-			} else {
-				convertedValue, convertDiags := convertStepParamsValueForState(convertStepParamsKey(key), value)
-				diags = append(diags, convertDiags...)
-
-				localParams[convertStepParamsKey(key)] = convertedValue
-			}
+		localParams, paramsDiags := buildTerraformBrowserStepParams(paramsMap)
+		diags = append(diags, paramsDiags...)
+		if _, ok := paramsMap["element"]; ok && forceElementUpdate == true {
+			// prevent overriding `element` in the local state with the one received from the backend, and
+			// keep the element from the local state instead
+			localParams["element"] = d.Get(fmt.Sprintf("browser_step.%d.params.0.element", stepIndex))
 		}
-
-		// If received an element from the backend, extract the user locator part to update the local state
-		if elementParams, ok := paramsMap["element"]; ok {
-			serializedElementParams, convertDiags := convertStepParamsValueForState("element", elementParams)
-			diags = append(diags, convertDiags...)
-
-			var stepElement interface{}
-			utils.GetMetadataFromJSON([]byte(serializedElementParams.(string)), &stepElement)
-			if elementUserLocator, ok := stepElement.(map[string]interface{})["userLocator"]; ok {
-				userLocator := elementUserLocator.(map[string]interface{})
-				values := userLocator["values"]
-				value := values.([]interface{})[0]
-
-				localElementUserLocator := map[string]interface{}{
-					"fail_test_on_cannot_locate": userLocator["failTestOnCannotLocate"],
-					"value": []map[string]interface{}{
-						value.(map[string]interface{}),
-					},
-				}
-
-				localParams["element_user_locator"] = []map[string]interface{}{localElementUserLocator}
-			}
+		if _, ok := paramsMap["files"]; ok {
+			// prevent overriding `files` in the local state with the one received from the backend, and
+			// keep the files from the local state instead
+			localParams["files"] = d.Get(fmt.Sprintf("browser_step.%d.params.0.files", stepIndex))
 		}
 
 		localStep["params"] = []interface{}{localParams}
@@ -5184,6 +5564,113 @@ func decompressAndDecodeValue(value string, acceptBase64Only bool) (string, erro
 	return string(compressedProtoFile), nil
 }
 
+// buildTerraformBrowserStepParams converts the params of a browser step returned by the API to the `params` block
+// of a `browser_step`.
+func buildTerraformBrowserStepParams(paramsMap map[string]interface{}) (map[string]interface{}, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	localParams := make(map[string]interface{})
+
+	for key, value := range paramsMap {
+		convertedValue, convertDiags := convertStepParamsValueForState(convertStepParamsKey(key), value)
+		diags = append(diags, convertDiags...)
+
+		localParams[convertStepParamsKey(key)] = convertedValue
+	}
+
+	// If received an element from the backend, extract the user locator part to update the local state
+	if elementParams, ok := paramsMap["element"]; ok {
+		serializedElementParams, convertDiags := convertStepParamsValueForState("element", elementParams)
+		diags = append(diags, convertDiags...)
+
+		var stepElement interface{}
+		utils.GetMetadataFromJSON([]byte(serializedElementParams.(string)), &stepElement)
+		if elementUserLocator, ok := stepElement.(map[string]interface{})["userLocator"]; ok {
+			userLocator := elementUserLocator.(map[string]interface{})
+			values := userLocator["values"]
+			value := values.([]interface{})[0]
+
+			localElementUserLocator := map[string]interface{}{
+				"fail_test_on_cannot_locate": userLocator["failTestOnCannotLocate"],
+				"value": []map[string]interface{}{
+					value.(map[string]interface{}),
+				},
+			}
+
+			localParams["element_user_locator"] = []map[string]interface{}{localElementUserLocator}
+		}
+	}
+
+	return localParams, diags
+}
+
+// SyntheticsBrowserStepsFromRecording converts the steps of a browser test recording, as exported by Datadog, to
+// `browser_step` blocks. The recording is either the exported test, or the list of its steps.
+func SyntheticsBrowserStepsFromRecording(recording []byte) ([]map[string]interface{}, error) {
+	var steps []datadogV1.SyntheticsStep
+	if err := json.Unmarshal(recording, &steps); err != nil {
+		var test struct {
+			Steps *[]datadogV1.SyntheticsStep `json:"steps"`
+		}
+		if err := json.Unmarshal(recording, &test); err != nil || test.Steps == nil {
+			return nil, fmt.Errorf("recording is neither a browser test nor a list of steps")
+		}
+		steps = *test.Steps
+	}
+
+	var errs []string
+	localSteps := make([]map[string]interface{}, 0, len(steps))
+	for i, step := range steps {
+		// Steps with an unknown type are not parsed by the client
+		if step.UnparsedObject != nil {
+			errs = append(errs, fmt.Sprintf("step %d (%v): unsupported step type %v", i, step.UnparsedObject["name"], step.UnparsedObject["type"]))
+			continue
+		}
+		if !step.HasType() {
+			errs = append(errs, fmt.Sprintf("step %d (%s): missing step type", i, step.GetName()))
+			continue
+		}
+		stepType := step.GetType()
+
+		paramsMap, _ := step.GetParams().(map[string]interface{})
+		supported := map[string]bool{}
+		for _, key := range getParamsKeysForStepType(stepType) {
+			supported[convertStepParamsKey(key)] = true
+		}
+		var unsupported []string
+		for key := range paramsMap {
+			if !supported[key] {
+				unsupported = append(unsupported, key)
+			}
+		}
+		if len(unsupported) > 0 {
+			sort.Strings(unsupported)
+			errs = append(errs, fmt.Sprintf("step %d (%s): unsupported params for %s steps: %s", i, step.GetName(), stepType, strings.Join(unsupported, ", ")))
+			continue
+		}
+
+		localParams, diags := buildTerraformBrowserStepParams(paramsMap)
+		if diags.HasError() {
+			errs = append(errs, fmt.Sprintf("step %d (%s): %s", i, step.GetName(), diags[0].Summary))
+			continue
+		}
+		localSteps = append(localSteps, map[string]interface{}{
+			"name":            step.GetName(),
+			"type":            string(stepType),
+			"allow_failure":   step.GetAllowFailure(),
+			"always_execute":  step.GetAlwaysExecute(),
+			"exit_if_succeed": step.GetExitIfSucceed(),
+			"is_critical":     step.GetIsCritical(),
+			"timeout":         step.GetTimeout(),
+			"no_screenshot":   step.GetNoScreenshot(),
+			"params":          []interface{}{localParams},
+		})
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+	return localSteps, nil
+}
+
 func convertStepParamsValueForConfig(stepType interface{}, key string, value interface{}) (interface{}, diag.Diagnostics) {
 	diags := diag.Diagnostics{}
 
@@ -0,0 +1,86 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatadogDashboardsDatasource(t *testing.T) {
+	t.Parallel()
+	ctx, _, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	uniq := uniqueEntityName(ctx, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceDashboardsFilterConfig(uniq),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.datadog_dashboards.by_title", "dashboards.#", "2"),
+					resource.TestCheckNoResourceAttr("data.datadog_dashboards.by_title", "dashboards.0.tags.#"),
+					resource.TestCheckResourceAttr("data.datadog_dashboards.by_tag", "dashboards.#", "1"),
+					resource.TestCheckResourceAttr("data.datadog_dashboards.by_tag", "dashboards.0.title", uniq+" one"),
+					resource.TestCheckResourceAttr("data.datadog_dashboards.by_tag", "dashboards.0.layout_type", "ordered"),
+					resource.TestCheckResourceAttr("data.datadog_dashboards.by_tag", "dashboards.0.tags.#", "1"),
+					resource.TestCheckResourceAttr("data.datadog_dashboards.by_tag", "dashboards.0.tags.0", "team:tf-test"),
+					resource.TestCheckResourceAttrSet("data.datadog_dashboards.by_tag", "dashboards.0.url"),
+					resource.TestCheckResourceAttr("data.datadog_dashboards.in_list", "dashboards.#", "1"),
+					resource.TestCheckResourceAttr("data.datadog_dashboards.in_list", "dashboards.0.title", uniq+" two"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceDashboardsFilterConfig(uniq string) string {
+	return fmt.Sprintf(`
+resource "datadog_dashboard" "one" {
+  title       = "%[1]s one"
+  layout_type = "ordered"
+  tags        = ["team:tf-test"]
+  widget {
+    note_definition {
+      content = "one"
+    }
+  }
+}
+
+resource "datadog_dashboard" "two" {
+  title       = "%[1]s two"
+  layout_type = "ordered"
+  widget {
+    note_definition {
+      content = "two"
+    }
+  }
+}
+
+resource "datadog_dashboard_list" "list" {
+  name = "%[1]s"
+  dash_item {
+    type    = "custom_timeboard"
+    dash_id = datadog_dashboard.two.id
+  }
+}
+
+data "datadog_dashboards" "by_title" {
+  filter_title = "%[1]s"
+  depends_on   = [datadog_dashboard.one, datadog_dashboard.two]
+}
+
+data "datadog_dashboards" "by_tag" {
+  filter_title = "%[1]s"
+  filter_tags  = ["team:*"]
+  depends_on   = [datadog_dashboard.one, datadog_dashboard.two]
+}
+
+data "datadog_dashboards" "in_list" {
+  filter_title             = "%[1]s"
+  filter_dashboard_list_id = datadog_dashboard_list.list.id
+}
+`, uniq)
+}
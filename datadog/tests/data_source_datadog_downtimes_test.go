@@ -0,0 +1,107 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatadogDowntimesDatasource(t *testing.T) {
+	t.Parallel()
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	uniq := strings.ToLower(uniqueEntityName(ctx, t))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testAccCheckDatadogDowntimeScheduleDestroy(providers.frameworkProvider),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceDowntimesConfig(uniq),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.datadog_downtimes.by_tag", "downtimes.#", "2"),
+					resource.TestCheckResourceAttr("data.datadog_downtimes.current", "downtimes.#", "1"),
+					resource.TestCheckResourceAttrPair("data.datadog_downtimes.current", "downtimes.0.id", "datadog_downtime_schedule.now", "id"),
+					resource.TestCheckResourceAttr("data.datadog_downtimes.current", "downtimes.0.status", "active"),
+					resource.TestCheckResourceAttr("data.datadog_downtimes.current", "downtimes.0.scope", "env:staging"),
+					resource.TestCheckResourceAttr("data.datadog_downtimes.current", "downtimes.0.recurring", "false"),
+					resource.TestCheckResourceAttr("data.datadog_downtimes.current", "downtimes.0.monitor_tags.#", "2"),
+					resource.TestCheckNoResourceAttr("data.datadog_downtimes.current", "downtimes.0.monitor_id"),
+					resource.TestCheckResourceAttrSet("data.datadog_downtimes.current", "downtimes.0.start"),
+					resource.TestCheckNoResourceAttr("data.datadog_downtimes.current", "downtimes.0.end"),
+					resource.TestCheckResourceAttrSet("data.datadog_downtimes.current", "downtimes.0.creator_id"),
+					resource.TestCheckResourceAttrSet("data.datadog_downtimes.current", "downtimes.0.creator_handle"),
+					resource.TestCheckResourceAttr("data.datadog_downtimes.scheduled", "downtimes.#", "1"),
+					resource.TestCheckResourceAttrPair("data.datadog_downtimes.scheduled", "downtimes.0.id", "datadog_downtime_schedule.later", "id"),
+					resource.TestCheckResourceAttr("data.datadog_downtimes.scheduled", "downtimes.0.start", "2050-01-02T03:04:05Z"),
+					resource.TestCheckResourceAttr("data.datadog_downtimes.scheduled", "downtimes.0.end", "2050-01-03T03:04:05Z"),
+					resource.TestCheckResourceAttr("data.datadog_downtimes.scheduled", "downtimes.0.message", "Planned maintenance"),
+					resource.TestCheckResourceAttr("data.datadog_downtimes.none", "downtimes.#", "0"),
+					resource.TestCheckResourceAttr("data.datadog_downtimes.by_scope", "downtimes.#", "2"),
+					resource.TestCheckResourceAttr("data.datadog_downtimes.by_scope_prefix", "downtimes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceDowntimesConfig(uniq string) string {
+	return fmt.Sprintf(`
+resource "datadog_downtime_schedule" "now" {
+  scope = "env:staging"
+  monitor_identifier {
+    monitor_tags = ["test:%[1]s", "service:now"]
+  }
+  one_time_schedule {
+  }
+}
+
+resource "datadog_downtime_schedule" "later" {
+  scope   = "env:staging AND host:%[1]s"
+  message = "Planned maintenance"
+  monitor_identifier {
+    monitor_tags = ["test:%[1]s", "service:later"]
+  }
+  one_time_schedule {
+    start = "2050-01-02T03:04:05Z"
+    end   = "2050-01-03T03:04:05Z"
+  }
+}
+
+data "datadog_downtimes" "by_tag" {
+  filter_monitor_tags = ["test:%[1]s"]
+  depends_on          = [datadog_downtime_schedule.now, datadog_downtime_schedule.later]
+}
+
+data "datadog_downtimes" "current" {
+  current_only        = true
+  filter_monitor_tags = ["test:%[1]s"]
+  depends_on          = [datadog_downtime_schedule.now, datadog_downtime_schedule.later]
+}
+
+data "datadog_downtimes" "scheduled" {
+  filter_scope  = "host:%[1]s"
+  filter_status = "scheduled"
+  depends_on    = [datadog_downtime_schedule.now, datadog_downtime_schedule.later]
+}
+
+data "datadog_downtimes" "by_scope" {
+  filter_scope        = "env:staging"
+  filter_monitor_tags = ["test:%[1]s"]
+  depends_on          = [datadog_downtime_schedule.now, datadog_downtime_schedule.later]
+}
+
+data "datadog_downtimes" "by_scope_prefix" {
+  filter_scope        = "env:stag"
+  filter_monitor_tags = ["test:%[1]s"]
+  depends_on          = [datadog_downtime_schedule.now, datadog_downtime_schedule.later]
+}
+
+data "datadog_downtimes" "none" {
+  filter_monitor_tags = ["test:%[1]s", "service:other"]
+  depends_on          = [datadog_downtime_schedule.now, datadog_downtime_schedule.later]
+}`, uniq)
+}
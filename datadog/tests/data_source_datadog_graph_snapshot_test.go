@@ -0,0 +1,96 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatadogGraphSnapshotDatasource(t *testing.T) {
+	t.Parallel()
+	ctx, accProviders := testAccProviders(context.Background(), t)
+	end := clockFromContext(ctx).Now().Unix()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: accProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceGraphSnapshotMetricQueryConfig(end),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.datadog_graph_snapshot.foo", "end", fmt.Sprint(end)),
+					resource.TestCheckResourceAttr("data.datadog_graph_snapshot.foo", "start", fmt.Sprint(end-3600)),
+					resource.TestCheckResourceAttr("data.datadog_graph_snapshot.foo", "graph_def", ""),
+					resource.TestMatchResourceAttr("data.datadog_graph_snapshot.foo", "snapshot_url", regexp.MustCompile("^https://")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatadogGraphSnapshotDatasourceRequest(t *testing.T) {
+	t.Parallel()
+	ctx, accProviders := testAccProviders(context.Background(), t)
+	end := clockFromContext(ctx).Now().Unix()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: accProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceGraphSnapshotRequestConfig(end),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.datadog_graph_snapshot.foo", "start", fmt.Sprint(end-1800)),
+					resource.TestCheckResourceAttr("data.datadog_graph_snapshot.foo", "graph_def", `{"requests":[{"display_type":"line","on_right_yaxis":false,"q":"avg:system.cpu.user{*}"}],"title":"CPU","type":"timeseries"}`),
+					resource.TestMatchResourceAttr("data.datadog_graph_snapshot.foo", "snapshot_url", regexp.MustCompile("^https://")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatadogGraphSnapshotDatasourceInvalidTimeframe(t *testing.T) {
+	t.Parallel()
+	_, accProviders := testAccProviders(context.Background(), t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: accProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "datadog_graph_snapshot" "foo" {
+  metric_query = "avg:system.load.1{*}"
+  timeframe    = "an hour"
+}`,
+				ExpectError: regexp.MustCompile("must be a valid duration"),
+			},
+		},
+	})
+}
+
+func testAccDatasourceGraphSnapshotMetricQueryConfig(end int64) string {
+	return fmt.Sprintf(`
+data "datadog_graph_snapshot" "foo" {
+  metric_query = "avg:system.load.1{*}"
+  title        = "System load"
+  end          = %d
+}`, end)
+}
+
+func testAccDatasourceGraphSnapshotRequestConfig(end int64) string {
+	return fmt.Sprintf(`
+data "datadog_graph_snapshot" "foo" {
+  title     = "CPU"
+  timeframe = "30m"
+  end       = %d
+
+  request {
+    q            = "avg:system.cpu.user{*}"
+    display_type = "line"
+  }
+}`, end)
+}
@@ -0,0 +1,129 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatadogOnCallRoutingSimulationDatasource(t *testing.T) {
+	t.Parallel()
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	uniq := strings.ToLower(uniqueEntityName(ctx, t))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testAccCheckDatadogOnCallTeamRoutingRulesDestroy(providers.frameworkProvider),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceOnCallRoutingSimulationConfig(uniq),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.datadog_on_call_routing_simulation.prod_p1", "matched", "true"),
+					resource.TestCheckResourceAttr("data.datadog_on_call_routing_simulation.prod_p1", "rule_index", "0"),
+					resource.TestCheckResourceAttr("data.datadog_on_call_routing_simulation.prod_p1", "rule_query", "tags.env:prod priority:(P1 OR P2)"),
+					resource.TestCheckResourceAttr("data.datadog_on_call_routing_simulation.prod_p1", "urgency", "high"),
+					resource.TestCheckResourceAttrPair("data.datadog_on_call_routing_simulation.prod_p1", "escalation_policy", "datadog_on_call_escalation_policy.primary", "id"),
+					resource.TestCheckResourceAttrPair("data.datadog_on_call_routing_simulation.prod_p1", "rule_id", "datadog_on_call_team_routing_rules.rules", "rule.0.id"),
+
+					resource.TestCheckResourceAttr("data.datadog_on_call_routing_simulation.checkout_business_hours", "rule_index", "1"),
+					resource.TestCheckNoResourceAttr("data.datadog_on_call_routing_simulation.checkout_business_hours", "urgency"),
+					resource.TestCheckNoResourceAttr("data.datadog_on_call_routing_simulation.checkout_business_hours", "escalation_policy"),
+
+					resource.TestCheckResourceAttr("data.datadog_on_call_routing_simulation.checkout_weekend", "rule_index", "2"),
+					resource.TestCheckResourceAttr("data.datadog_on_call_routing_simulation.checkout_weekend", "urgency", "low"),
+					resource.TestCheckResourceAttrPair("data.datadog_on_call_routing_simulation.checkout_weekend", "escalation_policy", "datadog_on_call_escalation_policy.primary", "id"),
+
+					resource.TestCheckResourceAttr("data.datadog_on_call_routing_simulation.staging", "matched", "false"),
+					resource.TestCheckNoResourceAttr("data.datadog_on_call_routing_simulation.staging", "rule_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceOnCallRoutingSimulationConfig(uniq string) string {
+	return fmt.Sprintf(`
+resource "datadog_user" "responder" {
+  email                = "%[1]s@example.com"
+  send_user_invitation = false
+}
+
+resource "datadog_team" "team" {
+  description = "Description"
+  handle      = "team-%[1]s"
+  name        = "team-%[1]s"
+}
+
+resource "datadog_on_call_escalation_policy" "primary" {
+  name = "%[1]s"
+  step {
+    assignment             = "default"
+    escalate_after_seconds = 300
+    target {
+      user = datadog_user.responder.id
+    }
+  }
+}
+
+resource "datadog_on_call_team_routing_rules" "rules" {
+  id = datadog_team.team.id
+  rule {
+    query             = "tags.env:prod priority:(P1 OR P2)"
+    escalation_policy = datadog_on_call_escalation_policy.primary.id
+    urgency           = "dynamic"
+  }
+  rule {
+    query = "tags.service:checkout"
+    action {
+      send_slack_message {
+        workspace = "workspace"
+        channel   = "channel"
+      }
+    }
+    time_restrictions {
+      time_zone = "Europe/Paris"
+      restriction {
+        start_day  = "monday"
+        start_time = "09:00:00"
+        end_day    = "friday"
+        end_time   = "18:00:00"
+      }
+    }
+  }
+  rule {
+    query             = "-tags.env:staging"
+    escalation_policy = datadog_on_call_escalation_policy.primary.id
+    urgency           = "low"
+  }
+}
+
+data "datadog_on_call_routing_simulation" "prod_p1" {
+  team_id  = datadog_on_call_team_routing_rules.rules.id
+  tags     = ["env:prod", "service:checkout"]
+  priority = "P1"
+}
+
+data "datadog_on_call_routing_simulation" "checkout_business_hours" {
+  team_id   = datadog_on_call_team_routing_rules.rules.id
+  tags      = ["env:prod", "service:checkout"]
+  priority  = "P3"
+  timestamp = "2050-01-05T10:00:00+01:00"
+}
+
+data "datadog_on_call_routing_simulation" "checkout_weekend" {
+  team_id   = datadog_on_call_team_routing_rules.rules.id
+  tags      = ["env:prod", "service:checkout"]
+  priority  = "P3"
+  timestamp = "2050-01-08T10:00:00+01:00"
+}
+
+data "datadog_on_call_routing_simulation" "staging" {
+  team_id   = datadog_on_call_team_routing_rules.rules.id
+  tags      = ["env:staging"]
+  timestamp = "2050-01-08T10:00:00+01:00"
+}`, uniq)
+}
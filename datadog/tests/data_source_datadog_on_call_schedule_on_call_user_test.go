@@ -0,0 +1,81 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatadogOnCallScheduleOnCallUserDatasource(t *testing.T) {
+	t.Parallel()
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	uniq := strings.ToLower(uniqueEntityName(ctx, t))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testAccCheckDatadogOnCallScheduleDestroy(providers.frameworkProvider),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceOnCallScheduleOnCallUserConfig(uniq),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.datadog_on_call_schedule_on_call_user.first", "user_id", "datadog_user.first", "id"),
+					resource.TestCheckResourceAttr("data.datadog_on_call_schedule_on_call_user.first", "user_email", uniq+"-first@example.com"),
+					resource.TestCheckResourceAttr("data.datadog_on_call_schedule_on_call_user.first", "shift_start", "2050-01-03T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.datadog_on_call_schedule_on_call_user.first", "shift_end", "2050-01-04T00:00:00Z"),
+					resource.TestCheckResourceAttrPair("data.datadog_on_call_schedule_on_call_user.second", "user_id", "datadog_user.second", "id"),
+					resource.TestCheckResourceAttr("data.datadog_on_call_schedule_on_call_user.second", "user_email", uniq+"-second@example.com"),
+					resource.TestCheckResourceAttr("data.datadog_on_call_schedule_on_call_user.second", "shift_start", "2050-01-04T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.datadog_on_call_schedule_on_call_user.second", "shift_end", "2050-01-05T00:00:00Z"),
+					resource.TestCheckNoResourceAttr("data.datadog_on_call_schedule_on_call_user.nobody", "user_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceOnCallScheduleOnCallUserConfig(uniq string) string {
+	return fmt.Sprintf(`
+resource "datadog_user" "first" {
+  email                = "%[1]s-first@example.com"
+  send_user_invitation = false
+}
+
+resource "datadog_user" "second" {
+  email                = "%[1]s-second@example.com"
+  send_user_invitation = false
+}
+
+resource "datadog_on_call_schedule" "rotation" {
+  name      = "%[1]s"
+  time_zone = "UTC"
+  layer {
+    name           = "Daily rotation"
+    effective_date = "2050-01-03T00:00:00Z"
+    end_date       = "2050-02-01T00:00:00Z"
+    rotation_start = "2050-01-03T00:00:00Z"
+    interval {
+      days = 1
+    }
+    users = [datadog_user.first.id, datadog_user.second.id]
+  }
+}
+
+data "datadog_on_call_schedule_on_call_user" "first" {
+  schedule_id = datadog_on_call_schedule.rotation.id
+  at          = "2050-01-03T12:00:00Z"
+}
+
+data "datadog_on_call_schedule_on_call_user" "second" {
+  schedule_id = datadog_on_call_schedule.rotation.id
+  at          = "2050-01-04T13:00:00+01:00"
+}
+
+data "datadog_on_call_schedule_on_call_user" "nobody" {
+  schedule_id = datadog_on_call_schedule.rotation.id
+  at          = "2050-03-01T00:00:00Z"
+}`, uniq)
+}
@@ -0,0 +1,108 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDatadogServiceLevelObjectiveStatusDatasource(t *testing.T) {
+	t.Parallel()
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	uniq := uniqueEntityName(ctx, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			return destroyServiceLevelObjectiveHelper(providers.frameworkProvider.Auth, s, providers.frameworkProvider.DatadogApiInstances)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceServiceLevelObjectiveStatusConfig(uniq),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.datadog_service_level_objective_status.metric", "id", "datadog_service_level_objective.metric", "id"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.metric", "name", uniq+" metric"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.metric", "type", "metric"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.metric", "budget_exhausted", "true"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.metric", "error_budget_remaining", "-100"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.metric", "timeframes.#", "2"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.metric", "timeframes.0.timeframe", "7d"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.metric", "timeframes.0.target", "99.75"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.metric", "timeframes.0.sli_value", "99.5"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.metric", "timeframes.0.burn_rate", "2"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.metric", "timeframes.0.error_budget_remaining", "-100"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.metric", "timeframes.0.budget_exhausted", "true"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.metric", "timeframes.0.groups.#", "0"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.metric", "timeframes.1.timeframe", "30d"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.metric", "timeframes.1.burn_rate", "0.5"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.metric", "timeframes.1.error_budget_remaining", "50"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.metric", "timeframes.1.budget_exhausted", "false"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.grouped", "type", "monitor"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.grouped", "budget_exhausted", "true"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.grouped", "error_budget_remaining", "0"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.grouped", "timeframes.#", "1"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.grouped", "timeframes.0.error_budget_remaining", "50"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.grouped", "timeframes.0.budget_exhausted", "false"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.grouped", "timeframes.0.groups.#", "2"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.grouped", "timeframes.0.groups.0.group", "host:a"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.grouped", "timeframes.0.groups.0.error_budget_remaining", "100"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.grouped", "timeframes.0.groups.0.budget_exhausted", "false"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.grouped", "timeframes.0.groups.1.group", "host:b"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.grouped", "timeframes.0.groups.1.burn_rate", "1"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_status.grouped", "timeframes.0.groups.1.budget_exhausted", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceServiceLevelObjectiveStatusConfig(uniq string) string {
+	return fmt.Sprintf(`
+resource "datadog_service_level_objective" "metric" {
+  name = "%[1]s metric"
+  metric {
+    numerator   = "sum:my.metric{type:good}.as_count()"
+    denominator = "sum:my.metric{*}.as_count()"
+  }
+  thresholds {
+    timeframe = "7d"
+    target    = 99.75
+  }
+  thresholds {
+    timeframe = "30d"
+    target    = 99
+  }
+}
+
+resource "datadog_monitor" "cpu" {
+  name    = "%[1]s cpu"
+  type    = "metric alert"
+  message = "CPU is high"
+  query   = "avg(last_5m):avg:system.cpu.user{*} by {host} > 80"
+}
+
+resource "datadog_service_level_objective" "grouped" {
+  name        = "%[1]s grouped"
+  monitor {
+    monitor_ids = [datadog_monitor.cpu.id]
+    groups      = ["host:a", "host:b"]
+  }
+  thresholds {
+    timeframe = "7d"
+    target    = 99
+  }
+}
+
+data "datadog_service_level_objective_status" "metric" {
+  slo_id = datadog_service_level_objective.metric.id
+}
+
+data "datadog_service_level_objective_status" "grouped" {
+  slo_id = datadog_service_level_objective.grouped.id
+}
+`, uniq)
+}
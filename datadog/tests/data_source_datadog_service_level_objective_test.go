@@ -6,42 +6,42 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/fwprovider"
 )
 
 func TestAccDatadogServiceLevelObjectiveDatasource(t *testing.T) {
 	t.Parallel()
-	ctx, accProviders := testAccProviders(context.Background(), t)
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
 	uniq := strings.ToLower(strings.ReplaceAll(uniqueEntityName(ctx, t), "-", "_"))
-	accProvider := testAccProvider(t, accProviders)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: accProviders,
-		CheckDestroy:      testAccCheckDatadogServiceLevelObjectiveDestroy(accProvider),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testAccCheckDatadogServiceLevelObjectiveDestroy(providers.frameworkProvider),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDatasourceServiceLevelObjectiveIDConfig(uniq),
-				Check:  checkServiceLevelObjectiveDatasourceAttrs(accProvider, uniq),
+				Check:  checkServiceLevelObjectiveDatasourceAttrs(providers.frameworkProvider, uniq),
 			},
 			{
 				Config: testAccDatasourceServiceLevelObjectiveNameFilterConfig(uniq),
-				Check:  checkServiceLevelObjectiveDatasourceAttrs(accProvider, uniq),
+				Check:  checkServiceLevelObjectiveDatasourceAttrs(providers.frameworkProvider, uniq),
 			},
 			{
 				Config: testAccDatasourceServiceLevelObjectiveTagsFilterConfig(uniq),
-				Check:  checkServiceLevelObjectiveDatasourceAttrs(accProvider, uniq),
+				Check:  checkServiceLevelObjectiveDatasourceAttrs(providers.frameworkProvider, uniq),
 			},
 			{
 				Config: testAccDatasourceServiceLevelObjectiveMetricsFilterConfig(uniq),
-				Check:  checkServiceLevelObjectiveDatasourceAttrs(accProvider, uniq),
+				Check:  checkServiceLevelObjectiveDatasourceAttrs(providers.frameworkProvider, uniq),
 			},
 		},
 	})
 }
 
-func checkServiceLevelObjectiveDatasourceAttrs(accProvider func() (*schema.Provider, error), uniq string) resource.TestCheckFunc {
+func checkServiceLevelObjectiveDatasourceAttrs(accProvider *fwprovider.FrameworkProvider, uniq string) resource.TestCheckFunc {
 	return resource.ComposeTestCheckFunc(
 		testAccCheckDatadogServiceLevelObjectiveExists(accProvider, ""),
 		resource.TestCheckResourceAttr("data.datadog_service_level_objective.foo", "name", uniq),
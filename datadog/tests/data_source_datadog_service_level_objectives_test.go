@@ -6,54 +6,54 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/fwprovider"
 )
 
 func TestAccDatadogServiceLevelObjectivesDatasource(t *testing.T) {
 	t.Parallel()
-	ctx, accProviders := testAccProviders(context.Background(), t)
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
 	sloName := strings.ToLower(strings.ReplaceAll(uniqueEntityName(ctx, t), "-", "_"))
-	accProvider := testAccProvider(t, accProviders)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: accProviders,
-		CheckDestroy:      testAccCheckDatadogServiceLevelObjectiveDestroy(accProvider),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testAccCheckDatadogServiceLevelObjectiveDestroy(providers.frameworkProvider),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDatasourceServiceLevelObjectivesIdsConfig(sloName),
-				Check:  checkServiceLevelObjectivesSingleResultDatasourceAttrs(accProvider, sloName),
+				Check:  checkServiceLevelObjectivesSingleResultDatasourceAttrs(providers.frameworkProvider, sloName),
 			},
 			{
 				Config: testAccDatasourceServiceLevelObjectivesNameFilterConfig(sloName),
-				Check:  checkServiceLevelObjectivesSingleResultDatasourceAttrs(accProvider, sloName),
+				Check:  checkServiceLevelObjectivesSingleResultDatasourceAttrs(providers.frameworkProvider, sloName),
 			},
 			{
 				Config: testAccDatasourceServiceLevelObjectivesWithQueryNameFilterConfig(sloName),
-				Check:  checkServiceLevelObjectivesSingleResultDatasourceAttrs(accProvider, sloName),
+				Check:  checkServiceLevelObjectivesSingleResultDatasourceAttrs(providers.frameworkProvider, sloName),
 			},
 			{
 				Config: testAccDatasourceServiceLevelObjectivesTagsFilterConfig(sloName),
-				Check:  checkServiceLevelObjectivesMultipleResultsDatasourceAttrs(accProvider, sloName),
+				Check:  checkServiceLevelObjectivesMultipleResultsDatasourceAttrs(providers.frameworkProvider, sloName),
 			},
 			{
 				Config: testAccDatasourceServiceLevelObjectivesWithQueryTagsFilterConfig(sloName),
-				Check:  checkServiceLevelObjectivesMultipleResultsDatasourceAttrs(accProvider, sloName),
+				Check:  checkServiceLevelObjectivesMultipleResultsDatasourceAttrs(providers.frameworkProvider, sloName),
 			},
 			{
 				Config: testAccDatasourceServiceLevelObjectivesMetricsFilterConfig(sloName),
-				Check:  checkServiceLevelObjectivesMultipleResultsDatasourceAttrs(accProvider, sloName),
+				Check:  checkServiceLevelObjectivesMultipleResultsDatasourceAttrs(providers.frameworkProvider, sloName),
 			},
 			{
 				Config: testAccDatasourceServiceLevelObjectivesWithQueryMetricsFilterConfig(sloName),
-				Check:  checkServiceLevelObjectivesMultipleResultsDatasourceAttrs(accProvider, sloName),
+				Check:  checkServiceLevelObjectivesMultipleResultsDatasourceAttrs(providers.frameworkProvider, sloName),
 			},
 		},
 	})
 }
 
-func checkServiceLevelObjectivesSingleResultDatasourceAttrs(accProvider func() (*schema.Provider, error), uniq string) resource.TestCheckFunc {
+func checkServiceLevelObjectivesSingleResultDatasourceAttrs(accProvider *fwprovider.FrameworkProvider, uniq string) resource.TestCheckFunc {
 	return resource.ComposeTestCheckFunc(
 		resource.TestCheckResourceAttrSet("data.datadog_service_level_objectives.foo", "id"),
 		resource.TestCheckResourceAttrSet("data.datadog_service_level_objectives.foo", "slos.0.id"),
@@ -62,7 +62,7 @@ func checkServiceLevelObjectivesSingleResultDatasourceAttrs(accProvider func() (
 	)
 }
 
-func checkServiceLevelObjectivesMultipleResultsDatasourceAttrs(accProvider func() (*schema.Provider, error), uniq string) resource.TestCheckFunc {
+func checkServiceLevelObjectivesMultipleResultsDatasourceAttrs(accProvider *fwprovider.FrameworkProvider, uniq string) resource.TestCheckFunc {
 	return resource.ComposeTestCheckFunc(
 		resource.TestCheckResourceAttrSet("data.datadog_service_level_objectives.foo", "id"),
 		resource.TestCheckResourceAttr("data.datadog_service_level_objectives.foo", "slos.#", "2"),
@@ -79,9 +79,8 @@ func testAccCheckDatadogServiceLevelObjectiveUniqueTagMetricConfig(uniq string)
 	return fmt.Sprintf(`
 resource "datadog_service_level_objective" "foo" {
   name = "%[1]s"
-  type = "metric"
   description = "some description about foo SLO"
-  query {
+  metric {
 	numerator = "sum:%[1]s{type:good}.as_count()"
 	denominator = "sum:my.metric{*}.as_count()"
   }
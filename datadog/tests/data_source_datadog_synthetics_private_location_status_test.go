@@ -0,0 +1,67 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatadogSyntheticsPrivateLocationStatusDatasource(t *testing.T) {
+	t.Parallel()
+	if !isReplaying() {
+		log.Println("Skipping private locations tests in non replaying mode")
+		return
+	}
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	uniq := uniqueEntityName(ctx, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testSyntheticsPrivateLocationIsDestroyed(providers.frameworkProvider),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceSyntheticsPrivateLocationStatusConfig(uniq),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.datadog_synthetics_private_location_status.up", "id", "datadog_synthetics_private_location.up", "id"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_private_location_status.up", "name", uniq+"_up"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_private_location_status.up", "status", "up"),
+					checkRessourceAttributeRegex("data.datadog_synthetics_private_location_status.up", "last_heartbeat", `^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:00Z$`),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_private_location_status.up", "worker_count", "3"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_private_location_status.up", "worker_versions.#", "2"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_private_location_status.up", "worker_versions.0", "1.56.0"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_private_location_status.up", "worker_versions.1", "1.57.0"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_private_location_status.down", "name", uniq+"_down"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_private_location_status.down", "status", "down"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_private_location_status.down", "last_heartbeat", ""),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_private_location_status.down", "worker_count", "0"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_private_location_status.down", "worker_versions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceSyntheticsPrivateLocationStatusConfig(uniq string) string {
+	return fmt.Sprintf(`
+resource "datadog_synthetics_private_location" "up" {
+  name = "%[1]s_up"
+}
+
+resource "datadog_synthetics_private_location" "down" {
+  name = "%[1]s_down"
+}
+
+data "datadog_synthetics_private_location_status" "up" {
+  private_location_id = datadog_synthetics_private_location.up.id
+}
+
+data "datadog_synthetics_private_location_status" "down" {
+  private_location_id = datadog_synthetics_private_location.down.id
+  lookback_minutes    = 60
+}
+`, uniq)
+}
@@ -0,0 +1,117 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatadogSyntheticsTestsDatasource(t *testing.T) {
+	t.Parallel()
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	uniq := uniqueEntityName(ctx, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testSyntheticsTestIsDestroyed(providers.sdkV2Provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceSyntheticsTestsConfig(uniq),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.datadog_synthetics_tests.by_tag", "tests.#", "2"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_tests.live", "tests.#", "1"),
+					resource.TestCheckResourceAttrPair("data.datadog_synthetics_tests.live", "tests.0.public_id", "datadog_synthetics_test.live", "id"),
+					resource.TestCheckResourceAttrPair("data.datadog_synthetics_tests.live", "tests.0.monitor_id", "datadog_synthetics_test.live", "monitor_id"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_tests.live", "tests.0.name", uniq+" live"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_tests.live", "tests.0.type", "api"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_tests.live", "tests.0.subtype", "http"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_tests.live", "tests.0.status", "live"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_tests.live", "tests.0.execution_rule", "blocking"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_tests.live", "tests.0.start_url", "https://www.datadoghq.com"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_tests.live", "tests.0.locations.#", "1"),
+					resource.TestCheckResourceAttrSet("data.datadog_synthetics_tests.live", "tests.0.creator_handle"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_tests.by_location", "tests.#", "1"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_tests.by_location", "tests.0.name", uniq+" paused"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_tests.browser", "tests.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceSyntheticsTestsConfig(uniq string) string {
+	return fmt.Sprintf(`
+resource "datadog_synthetics_test" "live" {
+  name      = "%[1]s live"
+  type      = "api"
+  subtype   = "http"
+  status    = "live"
+  locations = ["aws:eu-central-1"]
+  tags      = ["test:%[2]s", "env:prod"]
+  request_definition {
+    method = "GET"
+    url    = "https://www.datadoghq.com"
+  }
+  assertion {
+    type     = "statusCode"
+    operator = "is"
+    target   = "200"
+  }
+  options_list {
+    tick_every = 900
+    ci {
+      execution_rule = "blocking"
+    }
+  }
+}
+
+resource "datadog_synthetics_test" "paused" {
+  name      = "%[1]s paused"
+  type      = "api"
+  subtype   = "http"
+  status    = "paused"
+  locations = ["aws:us-east-2"]
+  tags      = ["test:%[2]s"]
+  request_definition {
+    method = "GET"
+    url    = "https://www.datadoghq.com"
+  }
+  assertion {
+    type     = "statusCode"
+    operator = "is"
+    target   = "200"
+  }
+  options_list {
+    tick_every = 900
+  }
+}
+
+data "datadog_synthetics_tests" "by_tag" {
+  filter_tags = ["test:%[2]s"]
+  depends_on  = [datadog_synthetics_test.live, datadog_synthetics_test.paused]
+}
+
+data "datadog_synthetics_tests" "live" {
+  filter_tags   = ["test:%[2]s", "env:*"]
+  filter_status = "live"
+  filter_type   = "api"
+  depends_on    = [datadog_synthetics_test.live, datadog_synthetics_test.paused]
+}
+
+data "datadog_synthetics_tests" "by_location" {
+  filter_tags     = ["test:%[2]s"]
+  filter_location = "aws:us-east-2"
+  depends_on      = [datadog_synthetics_test.live, datadog_synthetics_test.paused]
+}
+
+data "datadog_synthetics_tests" "browser" {
+  filter_tags = ["test:%[2]s"]
+  filter_type = "browser"
+  depends_on  = [datadog_synthetics_test.live, datadog_synthetics_test.paused]
+}
+`, uniq, strings.ToLower(uniq))
+}
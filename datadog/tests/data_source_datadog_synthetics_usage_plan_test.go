@@ -0,0 +1,128 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatadogSyntheticsUsagePlanDatasource(t *testing.T) {
+	t.Parallel()
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	uniq := uniqueEntityName(ctx, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testSyntheticsTestIsDestroyed(providers.sdkV2Provider),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceSyntheticsUsagePlanConfig(uniq),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.datadog_synthetics_usage_plan.plan", "test_count", "3"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_usage_plan.plan", "runs_per_hour", "12"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_usage_plan.plan", "max_runs_per_hour", "30"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_usage_plan.plan", "concurrent_executions_upper_bound", "5"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_usage_plan.plan", "locations.#", "2"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_usage_plan.plan", "locations.0.location", "aws:eu-central-1"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_usage_plan.plan", "locations.0.test_count", "3"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_usage_plan.plan", "locations.0.runs_per_hour", "8"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_usage_plan.plan", "locations.0.max_runs_per_hour", "18"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_usage_plan.plan", "locations.0.concurrent_executions_upper_bound", "4"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_usage_plan.plan", "locations.1.location", "aws:us-east-2"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_usage_plan.plan", "locations.1.test_count", "1"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_usage_plan.plan", "locations.1.runs_per_hour", "4"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_usage_plan.plan", "locations.1.max_runs_per_hour", "12"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_usage_plan.plan", "locations.1.concurrent_executions_upper_bound", "1"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_usage_plan.paused", "test_count", "1"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_usage_plan.paused", "runs_per_hour", "1"),
+					resource.TestCheckResourceAttr("data.datadog_synthetics_usage_plan.paused", "locations.0.location", "aws:ap-northeast-1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceSyntheticsUsagePlanConfig(uniq string) string {
+	return fmt.Sprintf(`
+resource "datadog_synthetics_test" "api" {
+  name      = "%[1]s api"
+  type      = "api"
+  subtype   = "http"
+  status    = "live"
+  locations = ["aws:eu-central-1", "aws:us-east-2"]
+  request_definition {
+    method = "GET"
+    url    = "https://www.datadoghq.com"
+  }
+  assertion {
+    type     = "statusCode"
+    operator = "is"
+    target   = "200"
+  }
+  options_list {
+    tick_every = 900
+    retry {
+      count    = 2
+      interval = 300
+    }
+  }
+}
+
+resource "datadog_synthetics_test" "browser" {
+  name       = "%[1]s browser"
+  type       = "browser"
+  status     = "live"
+  locations  = ["aws:eu-central-1"]
+  device_ids = ["chrome.laptop_large", "firefox.laptop_large"]
+  request_definition {
+    method = "GET"
+    url    = "https://www.datadoghq.com"
+  }
+  options_list {
+    tick_every = 3600
+  }
+}
+
+resource "datadog_synthetics_test" "paused" {
+  name      = "%[1]s paused"
+  type      = "api"
+  subtype   = "http"
+  status    = "paused"
+  locations = ["aws:ap-northeast-1"]
+  request_definition {
+    method = "GET"
+    url    = "https://www.datadoghq.com"
+  }
+  assertion {
+    type     = "statusCode"
+    operator = "is"
+    target   = "200"
+  }
+  options_list {
+    tick_every = 3600
+  }
+}
+
+data "datadog_synthetics_usage_plan" "plan" {
+  test_ids = [
+    datadog_synthetics_test.api.id,
+    datadog_synthetics_test.browser.id,
+    datadog_synthetics_test.paused.id,
+  ]
+  planned_test {
+    name        = "checkout"
+    tick_every  = 1800
+    locations   = ["aws:eu-central-1"]
+    retry_count = 1
+  }
+}
+
+data "datadog_synthetics_usage_plan" "paused" {
+  test_ids       = [datadog_synthetics_test.paused.id]
+  include_paused = true
+}
+`, uniq)
+}
@@ -0,0 +1,136 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccDatadogSyntheticsBrowserStepsFunction(t *testing.T) {
+	t.Parallel()
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	testName := uniqueEntityName(ctx, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testSyntheticsTestIsDestroyed(providers.sdkV2Provider),
+		// Provider functions are available from Terraform 1.8
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSyntheticsBrowserStepsFunctionConfig(testName, `[{"name": "Draw", "type": "drawOnCanvas", "params": {}}, {"name": "Wait", "type": "wait", "params": {"value": 1, "speed": 2}}]`),
+				ExpectError: regexp.MustCompile(`(?s)step 0 \(Draw\): unsupported step type\s+drawOnCanvas.*step 1 \(Wait\): unsupported params for wait\s+steps:\s+speed`),
+			},
+			{
+				Config: testAccSyntheticsBrowserStepsFunctionConfig(testName, testAccSyntheticsBrowserRecording),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("step_count", "4"),
+					resource.TestCheckOutput("username_element", `{"targetOuterHTML":"\u003cinput id=\"username\"\u003e"}`),
+					resource.TestCheckOutput("variable_name", "GREETING"),
+					resource.TestCheckOutput("user_locator", "#login"),
+					resource.TestCheckResourceAttr("datadog_synthetics_test.recorded", "browser_step.#", "4"),
+					resource.TestCheckResourceAttr("datadog_synthetics_test.recorded", "browser_step.0.name", "Type username"),
+					resource.TestCheckResourceAttr("datadog_synthetics_test.recorded", "browser_step.0.type", "typeText"),
+					resource.TestCheckResourceAttr("datadog_synthetics_test.recorded", "browser_step.0.params.0.value", "frog"),
+					resource.TestCheckResourceAttr("datadog_synthetics_test.recorded", "browser_step.1.type", "click"),
+					resource.TestCheckResourceAttr("datadog_synthetics_test.recorded", "browser_step.1.allow_failure", "true"),
+					resource.TestCheckResourceAttr("datadog_synthetics_test.recorded", "browser_step.1.params.0.element_user_locator.0.value.0.value", "#login"),
+					resource.TestCheckResourceAttr("datadog_synthetics_test.recorded", "browser_step.2.type", "extractFromJavascript"),
+					resource.TestCheckResourceAttr("datadog_synthetics_test.recorded", "browser_step.2.params.0.variable.0.name", "GREETING"),
+					resource.TestCheckResourceAttr("datadog_synthetics_test.recorded", "browser_step.3.type", "wait"),
+					resource.TestCheckResourceAttr("datadog_synthetics_test.recorded", "browser_step.3.params.0.value", "2"),
+				),
+			},
+		},
+	})
+}
+
+// testAccSyntheticsBrowserRecording is a browser test as exported by Datadog, some of its fields are omitted.
+const testAccSyntheticsBrowserRecording = `{
+  "name": "Login",
+  "type": "browser",
+  "steps": [
+    {"name": "Type username", "type": "typeText", "allowFailure": false, "timeout": 0, "params": {"value": "frog", "element": {"targetOuterHTML": "<input id=\"username\">"}}},
+    {"name": "Click on login", "type": "click", "allowFailure": true, "params": {"element": {"targetOuterHTML": "<button id=\"login\">", "userLocator": {"failTestOnCannotLocate": true, "values": [{"type": "css", "value": "#login"}]}}}},
+    {"name": "Extract greeting", "type": "extractFromJavascript", "params": {"code": "return 'hello';", "variable": {"name": "GREETING", "example": ""}}},
+    {"name": "Wait", "type": "wait", "public_id": "abc-def-ghi", "params": {"value": 2}}
+  ]
+}`
+
+func testAccSyntheticsBrowserStepsFunctionConfig(uniq string, recording string) string {
+	return fmt.Sprintf(`
+locals {
+  steps = provider::datadog::synthetics_browser_steps(%q)
+}
+
+output "step_count" {
+  value = length(local.steps)
+}
+
+output "username_element" {
+  value = local.steps[0].params.element
+}
+
+output "variable_name" {
+  value = local.steps[2].params.variable.name
+}
+
+output "user_locator" {
+  value = local.steps[1].params.element_user_locator.value.value
+}
+
+resource "datadog_synthetics_test" "recorded" {
+  type       = "browser"
+  name       = "%s"
+  status     = "paused"
+  locations  = ["aws:eu-central-1"]
+  device_ids = ["chrome.laptop_large"]
+  request_definition {
+    method = "GET"
+    url    = "https://www.datadoghq.com"
+  }
+  options_list {
+    tick_every = 900
+  }
+
+  dynamic "browser_step" {
+    for_each = local.steps
+    content {
+      name          = browser_step.value.name
+      type          = browser_step.value.type
+      allow_failure = browser_step.value.allow_failure
+      timeout       = browser_step.value.timeout
+      params {
+        code    = browser_step.value.params.code
+        element = browser_step.value.params.element
+        value   = browser_step.value.params.value
+        dynamic "element_user_locator" {
+          for_each = browser_step.value.params.element_user_locator[*]
+          content {
+            fail_test_on_cannot_locate = element_user_locator.value.fail_test_on_cannot_locate
+            value {
+              type  = element_user_locator.value.value.type
+              value = element_user_locator.value.value.value
+            }
+          }
+        }
+        dynamic "variable" {
+          for_each = browser_step.value.params.variable[*]
+          content {
+            name    = variable.value.name
+            example = variable.value.example
+          }
+        }
+      }
+    }
+  }
+}
+`, recording, uniq)
+}
@@ -63,6 +63,8 @@ var testFiles2EndpointTags = map[string]string{
 	"tests/data_source_datadog_csm_threats_policies_test":                     "cloud-workload-security",
 	"tests/data_source_datadog_dashboard_list_test":                           "dashboard-lists",
 	"tests/data_source_datadog_dashboard_test":                                "dashboard",
+	"tests/data_source_datadog_dashboards_test":                               "dashboard",
+	"tests/data_source_datadog_graph_snapshot_test":                           "snapshots",
 	"tests/data_source_datadog_hosts_test":                                    "hosts",
 	"tests/data_source_datadog_integration_aws_logs_services_test":            "integration-aws",
 	"tests/data_source_datadog_integration_aws_available_logs_services_test":  "integration-aws",
@@ -100,11 +102,17 @@ var testFiles2EndpointTags = map[string]string{
 	"tests/data_source_datadog_sensitive_data_scanner_standard_pattern_test":  "sensitive-data-scanner",
 	"tests/data_source_datadog_service_account_test":                          "users",
 	"tests/data_source_datadog_service_level_objective_test":                  "service-level-objectives",
+	"tests/data_source_datadog_service_level_objective_status_test":           "service-level-objectives",
+	"tests/resource_datadog_service_level_objective_burn_rate_alerts_test":    "service-level-objectives",
 	"tests/data_source_datadog_service_level_objectives_test":                 "service-level-objectives",
 	"tests/data_source_datadog_software_catalog_test":                         "software-catalog",
 	"tests/data_source_datadog_synthetics_global_variable_test":               "synthetics",
 	"tests/data_source_datadog_synthetics_locations_test":                     "synthetics",
 	"tests/data_source_datadog_synthetics_test_test":                          "synthetics",
+	"tests/data_source_datadog_downtimes_test":                                "downtimes",
+	"tests/data_source_datadog_synthetics_tests_test":                         "synthetics",
+	"tests/data_source_datadog_synthetics_usage_plan_test":                    "synthetics",
+	"tests/data_source_datadog_synthetics_private_location_status_test":       "synthetics",
 	"tests/data_source_datadog_team_memberships_test":                         "team",
 	"tests/data_source_datadog_team_test":                                     "team",
 	"tests/data_source_datadog_teams_test":                                    "teams",
@@ -112,6 +120,7 @@ var testFiles2EndpointTags = map[string]string{
 	"tests/data_source_datadog_users_test":                                    "users",
 	"tests/data_source_datadog_workflow_automation_test":                      "workflow_automation",
 	"tests/data_source_datadog_cost_budget_test":                              "cost-budget",
+	"tests/function_synthetics_browser_steps_test":                            "synthetics",
 	"tests/import_datadog_downtime_test":                                      "downtimes",
 	"tests/import_datadog_integration_pagerduty_test":                         "integration-pagerduty",
 	"tests/import_datadog_logs_pipeline_test":                                 "logs-pipelines",
@@ -151,6 +160,7 @@ var testFiles2EndpointTags = map[string]string{
 	"tests/resource_datadog_dashboard_json_test":                              "dashboards-json",
 	"tests/resource_datadog_dashboard_list_stream_storage_test":               "dashboards",
 	"tests/resource_datadog_dashboard_list_stream_test":                       "dashboards",
+	"tests/resource_datadog_dashboard_list_item_test":                         "dashboard-lists",
 	"tests/resource_datadog_dashboard_list_test":                              "dashboard-lists",
 	"tests/resource_datadog_dashboard_log_stream_test":                        "dashboards",
 	"tests/resource_datadog_dashboard_manage_status_test":                     "dashboards",
@@ -160,6 +170,7 @@ var testFiles2EndpointTags = map[string]string{
 	"tests/resource_datadog_dashboard_query_value_test":                       "dashboards",
 	"tests/resource_datadog_dashboard_run_workflow_test":                      "dashboards",
 	"tests/resource_datadog_dashboard_scatterplot_test":                       "dashboards",
+	"tests/resource_datadog_dashboard_share_test":                             "dashboards",
 	"tests/resource_datadog_dashboard_service_map_test":                       "dashboards",
 	"tests/resource_datadog_dashboard_slo_list_test":                          "dashboards",
 	"tests/resource_datadog_dashboard_slo_test":                               "dashboards",
@@ -244,6 +255,8 @@ var testFiles2EndpointTags = map[string]string{
 	"tests/resource_datadog_monitor_json_test":                                "monitors-json",
 	"tests/resource_datadog_monitor_notification_rule_test":                   "monitor-notification-rule",
 	"tests/resource_datadog_monitor_test":                                     "monitors",
+	"tests/data_source_datadog_on_call_routing_simulation_test":               "on-call",
+	"tests/data_source_datadog_on_call_schedule_on_call_user_test":            "on-call",
 	"tests/resource_datadog_on_call_escalation_policy_test":                   "on-call",
 	"tests/resource_datadog_on_call_schedule_test":                            "on-call",
 	"tests/resource_datadog_on_call_team_routing_rules_test":                  "on-call",
@@ -271,8 +284,13 @@ var testFiles2EndpointTags = map[string]string{
 	"tests/resource_datadog_slo_correction_test":                              "slo_correction",
 	"tests/resource_datadog_software_catalog_test":                            "software-catalog",
 	"tests/resource_datadog_spans_metric_test":                                "spans-metric",
+	"tests/resource_datadog_synthetics_api_test_test":                         "synthetics",
+	"tests/resource_datadog_synthetics_browser_test_test":                     "synthetics",
 	"tests/resource_datadog_synthetics_concurrency_cap_test":                  "synthetics",
 	"tests/resource_datadog_synthetics_global_variable_test":                  "synthetics",
+	"tests/resource_datadog_synthetics_mobile_test_test":                      "synthetics",
+	"tests/resource_datadog_synthetics_multistep_api_test_test":               "synthetics",
+	"tests/resource_datadog_synthetics_mobile_application_test":               "synthetics",
 	"tests/resource_datadog_synthetics_private_location_test":                 "synthetics",
 	"tests/resource_datadog_synthetics_test_test":                             "synthetics",
 	"tests/resource_datadog_team_link_test":                                   "team",
@@ -0,0 +1,117 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/fwprovider"
+)
+
+func TestAccDatadogDashboardListItem_Basic(t *testing.T) {
+	t.Parallel()
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	uniq := uniqueEntityName(ctx, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testAccCheckDatadogDashListDestroyWithFw(providers.frameworkProvider),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogDashboardListItemConfig(uniq),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("datadog_dashboard_list.shared", "dash_item.#", "1"),
+					resource.TestCheckResourceAttr("datadog_dashboard_list_item.team", "type", "custom_timeboard"),
+					resource.TestCheckResourceAttrPair("datadog_dashboard_list_item.team", "dashboard_list_id", "datadog_dashboard_list.shared", "id"),
+					resource.TestCheckResourceAttrPair("datadog_dashboard_list_item.team", "dash_id", "datadog_dashboard.team", "id"),
+					testAccCheckDatadogDashboardListItemCount(providers.frameworkProvider, "datadog_dashboard_list.shared", 2),
+				),
+			},
+			{
+				ResourceName:      "datadog_dashboard_list_item.team",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCheckDatadogDashboardListItemConfigRemoved(uniq),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("datadog_dashboard_list.shared", "dash_item.#", "1"),
+					testAccCheckDatadogDashboardListItemCount(providers.frameworkProvider, "datadog_dashboard_list.shared", 1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDatadogDashboardListItemCount(accProvider *fwprovider.FrameworkProvider, name string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, _ := strconv.ParseInt(s.RootModule().Resources[name].Primary.ID, 10, 64)
+		items, httpresp, err := accProvider.DatadogApiInstances.GetDashboardListsApiV2().GetDashboardListItems(accProvider.Auth, id)
+		if err != nil {
+			return fmt.Errorf("received an error retrieving dashboard list items: %s, %v", err, httpresp)
+		}
+		if len(items.GetDashboards()) != count {
+			return fmt.Errorf("expected %d dashboards in the list, got %d", count, len(items.GetDashboards()))
+		}
+		return nil
+	}
+}
+
+func testAccCheckDatadogDashboardListItemDashboards(uniq string) string {
+	return fmt.Sprintf(`
+resource "datadog_dashboard" "shared" {
+	title        = "%s-shared"
+	layout_type  = "ordered"
+	widget {
+		note_definition {
+			content = "shared"
+		}
+	}
+}
+
+resource "datadog_dashboard" "team" {
+	title        = "%s-team"
+	layout_type  = "ordered"
+	depends_on   = [datadog_dashboard.shared]
+	widget {
+		note_definition {
+			content = "team"
+		}
+	}
+}`, uniq, uniq)
+}
+
+func testAccCheckDatadogDashboardListItemConfig(uniq string) string {
+	return testAccCheckDatadogDashboardListItemDashboards(uniq) + fmt.Sprintf(`
+resource "datadog_dashboard_list" "shared" {
+	name                  = "%s"
+	ignore_external_items = true
+	dash_item {
+		type    = "custom_timeboard"
+		dash_id = datadog_dashboard.shared.id
+	}
+}
+
+resource "datadog_dashboard_list_item" "team" {
+	dashboard_list_id = datadog_dashboard_list.shared.id
+	dash_id           = datadog_dashboard.team.id
+	type              = "custom_timeboard"
+}`, uniq)
+}
+
+func testAccCheckDatadogDashboardListItemConfigRemoved(uniq string) string {
+	return testAccCheckDatadogDashboardListItemDashboards(uniq) + fmt.Sprintf(`
+resource "datadog_dashboard_list" "shared" {
+	name                  = "%s"
+	ignore_external_items = true
+	dash_item {
+		type    = "custom_timeboard"
+		dash_id = datadog_dashboard.shared.id
+	}
+}`, uniq)
+}
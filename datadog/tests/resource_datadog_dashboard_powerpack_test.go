@@ -1,6 +1,13 @@
 package test
 
-import "testing"
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
 
 const datadogDashboardPowerpackConfig = `
 resource "datadog_dashboard" "powerpack_dashboard" {
@@ -53,8 +60,45 @@ var datadogDashboardPowerpackAsserts = []string{
 
 	"layout_type = ordered",
 	"is_read_only = true",
+	"powerpack_checksums.% = 1",
 }
 
 func TestAccDatadogDashboardPowerpack(t *testing.T) {
 	testAccDatadogDashboardWidgetUtil(t, datadogDashboardPowerpackConfig, "datadog_dashboard.powerpack_dashboard", datadogDashboardPowerpackAsserts)
 }
+
+func TestAccDatadogDashboardPowerpackUndeclaredTemplateVariable(t *testing.T) {
+	ctx, accProviders := testAccProviders(context.Background(), t)
+	uniq := uniqueEntityName(ctx, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: accProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      datadogDashboardPowerpackConfigUndeclaredTemplateVariable(uniq),
+				ExpectError: regexp.MustCompile("template variable `undeclared` set in `controlled_externally` .* is not declared by the powerpack"),
+			},
+		},
+	})
+}
+
+func datadogDashboardPowerpackConfigUndeclaredTemplateVariable(uniq string) string {
+	return fmt.Sprintf(`
+resource "datadog_dashboard" "powerpack_dashboard" {
+	title         = "%s"
+	layout_type   = "ordered"
+
+  widget {
+    powerpack_definition {
+      powerpack_id = "3c3096da-7cec-11ee-9b6f-da7ad0900002"
+      template_variables {
+        controlled_externally {
+          name = "undeclared"
+          values = ["test"]
+        }
+      }
+    }
+  }
+}`, uniq)
+}
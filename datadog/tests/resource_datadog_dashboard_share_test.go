@@ -0,0 +1,154 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/fwprovider"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+)
+
+func TestAccDashboardShareBasic(t *testing.T) {
+	t.Parallel()
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	uniq := uniqueEntityName(ctx, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testAccCheckDatadogDashboardShareDestroy(providers.frameworkProvider),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogDashboardShare(uniq, "open", "1h"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDashboardShareExists(providers.frameworkProvider),
+					resource.TestCheckResourceAttr("datadog_dashboard_share.foo", "share_type", "open"),
+					resource.TestCheckResourceAttr("datadog_dashboard_share.foo", "title", uniq+" shared"),
+					resource.TestCheckResourceAttr("datadog_dashboard_share.foo", "global_time_live_span", "1h"),
+					resource.TestCheckResourceAttr("datadog_dashboard_share.foo", "selectable_template_var.#", "1"),
+					resource.TestCheckResourceAttr("datadog_dashboard_share.foo", "selectable_template_var.0.name", "env"),
+					resource.TestCheckResourceAttr("datadog_dashboard_share.foo", "selectable_template_var.0.visible_tags.#", "2"),
+					resource.TestCheckResourceAttrSet("datadog_dashboard_share.foo", "public_url"),
+				),
+			},
+			{
+				Config: testAccCheckDatadogDashboardShare(uniq, "open", "1d"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDashboardShareExists(providers.frameworkProvider),
+					resource.TestCheckResourceAttr("datadog_dashboard_share.foo", "global_time_live_span", "1d"),
+				),
+			},
+			{
+				ResourceName:      "datadog_dashboard_share.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDashboardShareInvalidInvitees(t *testing.T) {
+	t.Parallel()
+	ctx, _, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	uniq := uniqueEntityName(ctx, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "datadog_dashboard_share" "foo" {
+  dashboard_id   = "abc-def-ghi"
+  dashboard_type = "custom_timeboard"
+  share_type     = "open"
+  title          = "%s"
+  invitees       = ["user@example.com"]
+}`, uniq),
+				ExpectError: regexp.MustCompile("`invitees` can only be set when `share_type` is `invite`"),
+			},
+		},
+	})
+}
+
+func testAccCheckDatadogDashboardShare(uniq string, shareType string, liveSpan string) string {
+	return fmt.Sprintf(`
+resource "datadog_dashboard" "foo" {
+  title       = "%[1]s"
+  layout_type = "ordered"
+  template_variable {
+    name   = "env"
+    prefix = "env"
+  }
+  widget {
+    note_definition {
+      content = "shared"
+    }
+  }
+}
+
+resource "datadog_dashboard_share" "foo" {
+  dashboard_id          = datadog_dashboard.foo.id
+  dashboard_type        = "custom_timeboard"
+  share_type            = "%[2]s"
+  title                 = "%[1]s shared"
+  global_time_live_span = "%[3]s"
+
+  selectable_template_var {
+    name          = "env"
+    prefix        = "env"
+    default_value = "prod"
+    visible_tags  = ["prod", "staging"]
+  }
+}`, uniq, shareType, liveSpan)
+}
+
+func testAccCheckDatadogDashboardShareDestroy(accProvider *fwprovider.FrameworkProvider) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		apiInstances := accProvider.DatadogApiInstances
+		auth := accProvider.Auth
+
+		for _, r := range s.RootModule().Resources {
+			if r.Type != "datadog_dashboard_share" {
+				continue
+			}
+			err := utils.Retry(2, 10, func() error {
+				_, httpResp, err := apiInstances.GetDashboardsApiV1().GetPublicDashboard(auth, r.Primary.ID)
+				if err != nil {
+					if httpResp != nil && httpResp.StatusCode == 404 {
+						return nil
+					}
+					return &utils.RetryableError{Prob: fmt.Sprintf("received an error retrieving shared dashboard %s", err)}
+				}
+				return &utils.RetryableError{Prob: "Shared dashboard still exists"}
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckDatadogDashboardShareExists(accProvider *fwprovider.FrameworkProvider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		apiInstances := accProvider.DatadogApiInstances
+		auth := accProvider.Auth
+
+		for _, r := range s.RootModule().Resources {
+			if r.Type != "datadog_dashboard_share" {
+				continue
+			}
+			_, httpResp, err := apiInstances.GetDashboardsApiV1().GetPublicDashboard(auth, r.Primary.ID)
+			if err != nil {
+				return utils.TranslateClientError(err, httpResp, "error retrieving shared dashboard")
+			}
+		}
+		return nil
+	}
+}
@@ -4,11 +4,21 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"regexp"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 
 	"github.com/terraform-providers/terraform-provider-datadog/datadog/fwprovider"
 	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
@@ -59,6 +69,11 @@ func TestAccDowntimeScheduleBasicRecurring(t *testing.T) {
 					resource.TestCheckResourceAttr("datadog_downtime_schedule.t", "recurring_schedule.recurrence.1.start", "2042-07-15T01:02:03"),
 					resource.TestCheckResourceAttr("datadog_downtime_schedule.t", "recurring_schedule.recurrence.1.duration", "1w"),
 					resource.TestCheckResourceAttr("datadog_downtime_schedule.t", "recurring_schedule.recurrence.1.rrule", "FREQ=DAILY;INTERVAL=12"),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.t", "next_occurrences.#", "5"),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.t", "next_occurrences.0.start", "2042-07-13T01:02:03-04:00"),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.t", "next_occurrences.0.end", "2042-07-14T01:02:03-04:00"),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.t", "next_occurrences.3.start", "2042-07-15T01:02:03-04:00"),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.t", "next_occurrences.3.end", "2042-07-22T01:02:03-04:00"),
 					resource.TestCheckResourceAttr("datadog_downtime_schedule.t", "recurring_schedule.recurrence.2.start", "2042-07-17T01:02:03"),
 					resource.TestCheckResourceAttr("datadog_downtime_schedule.t", "recurring_schedule.recurrence.2.duration", "1m"),
 					resource.TestCheckResourceAttr("datadog_downtime_schedule.t", "recurring_schedule.recurrence.2.rrule", "FREQ=DAILY;INTERVAL=123"),
@@ -101,6 +116,34 @@ func TestAccDowntimeScheduleBasicRecurring(t *testing.T) {
 	})
 }
 
+func TestAccDowntimeScheduleInvalidRrule(t *testing.T) {
+	t.Parallel()
+	ctx, _, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	uniq := uniqueEntityName(ctx, t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: accProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "datadog_downtime_schedule" "t" {
+    scope = "env:(staging OR %v)"
+    monitor_identifier {
+      monitor_tags = ["cat:hat"]
+    }
+    recurring_schedule {
+        recurrence {
+		  duration = "1d"
+		  rrule    = "FREQ=WEEKLY;BYDAY=1MO"
+		}
+    }
+}`, uniq),
+				ExpectError: regexp.MustCompile("BYDAY ordinals such as `1` are only supported with a MONTHLY or YEARLY FREQ"),
+			},
+		},
+	})
+}
+
 func TestAccDowntimeScheduleBasicOneTime(t *testing.T) {
 	t.Parallel()
 	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
@@ -121,6 +164,9 @@ func TestAccDowntimeScheduleBasicOneTime(t *testing.T) {
 					resource.TestCheckResourceAttr("datadog_downtime_schedule.t", "scope", fmt.Sprintf("env:(staging OR %v)", uniq)),
 					resource.TestCheckResourceAttr("datadog_downtime_schedule.t", "one_time_schedule.start", "2050-01-02T03:04:05Z"),
 					resource.TestCheckNoResourceAttr("datadog_downtime_schedule.t", "one_time_schedule.end"),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.t", "next_occurrences.#", "1"),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.t", "next_occurrences.0.start", "2050-01-02T03:04:05Z"),
+					resource.TestCheckNoResourceAttr("datadog_downtime_schedule.t", "next_occurrences.0.end"),
 					resource.TestCheckResourceAttr("datadog_downtime_schedule.t", "notify_end_states.#", "3"),
 					resource.TestCheckTypeSetElemAttr("datadog_downtime_schedule.t", "notify_end_states.*", "alert"),
 					resource.TestCheckTypeSetElemAttr("datadog_downtime_schedule.t", "notify_end_states.*", "no data"),
@@ -211,6 +257,43 @@ func TestAccDowntimeScheduleChanged(t *testing.T) {
 	})
 }
 
+func TestAccDowntimeScheduleMovedFromDowntime(t *testing.T) {
+	t.Parallel()
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	uniq := uniqueEntityName(ctx, t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: accProviders,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		CheckDestroy: testAccCheckDatadogDowntimeScheduleDestroy(providers.frameworkProvider),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogDowntimeScheduleMoveSource(uniq),
+			},
+			{
+				Config: testAccCheckDatadogDowntimeScheduleMoveTarget(uniq),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDowntimeScheduleExists(providers.frameworkProvider),
+					resource.TestMatchResourceAttr("datadog_downtime_schedule.once", "id", regexp.MustCompile("^[0-9a-f-]{36}$")),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.once", "next_occurrences.0.start", "2050-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.once", "next_occurrences.0.end", "2050-01-01T01:00:00Z"),
+					resource.TestMatchResourceAttr("datadog_downtime_schedule.weekly", "id", regexp.MustCompile("^[0-9a-f-]{36}$")),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.weekly", "recurring_schedule.recurrence.0.rrule", "FREQ=WEEKLY;INTERVAL=1;BYDAY=MO,FR"),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.weekly", "recurring_schedule.recurrence.0.start", "2050-01-01T01:00:00"),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.weekly", "recurring_schedule.recurrence.0.duration", "2h"),
+				),
+			},
+		},
+	})
+}
+
 func getCheckNowDate(attributeName string, dateFormat string, currentTime time.Time) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Check that the calculated start now string is close to now
@@ -394,6 +477,70 @@ resource "datadog_downtime_schedule" "t" {
 }`, uniq)
 }
 
+func testAccCheckDatadogDowntimeScheduleMoveSource(uniq string) string {
+	return fmt.Sprintf(`
+resource "datadog_downtime" "once" {
+  scope        = ["env:staging", "service:%[1]v"]
+  start        = 2524608000
+  end          = 2524611600
+  message      = "moved downtime"
+  monitor_tags = ["cat:hat"]
+}
+
+resource "datadog_downtime" "weekly" {
+  scope        = ["env:staging"]
+  start        = 2524608000
+  end          = 2524615200
+  timezone     = "Europe/Paris"
+  monitor_tags = ["weekly:%[1]v"]
+  recurrence {
+    type      = "weeks"
+    period    = 1
+    week_days = ["Mon", "Fri"]
+  }
+}`, uniq)
+}
+
+func testAccCheckDatadogDowntimeScheduleMoveTarget(uniq string) string {
+	return fmt.Sprintf(`
+moved {
+  from = datadog_downtime.once
+  to   = datadog_downtime_schedule.once
+}
+
+moved {
+  from = datadog_downtime.weekly
+  to   = datadog_downtime_schedule.weekly
+}
+
+resource "datadog_downtime_schedule" "once" {
+  scope   = "env:staging AND service:%[1]v"
+  message = "moved downtime"
+  monitor_identifier {
+    monitor_tags = ["cat:hat"]
+  }
+  one_time_schedule {
+    start = "2050-01-01T00:00:00Z"
+    end   = "2050-01-01T01:00:00Z"
+  }
+}
+
+resource "datadog_downtime_schedule" "weekly" {
+  scope = "env:staging"
+  monitor_identifier {
+    monitor_tags = ["weekly:%[1]v"]
+  }
+  recurring_schedule {
+    timezone = "Europe/Paris"
+    recurrence {
+      start    = "2050-01-01T01:00:00"
+      duration = "2h"
+      rrule    = "FREQ=WEEKLY;INTERVAL=1;BYDAY=MO,FR"
+    }
+  }
+}`, uniq)
+}
+
 func testAccCheckDatadogDowntimeScheduleDestroy(accProvider *fwprovider.FrameworkProvider) func(*terraform.State) error {
 	return func(s *terraform.State) error {
 		apiInstances := accProvider.DatadogApiInstances
@@ -452,3 +599,124 @@ func downtimeScheduleExistsHelper(auth context.Context, s *terraform.State, apiI
 	}
 	return nil
 }
+
+func TestDowntimeScheduleResourceMoveState(t *testing.T) {
+	ctx := context.Background()
+	r := fwprovider.NewDowntimeScheduleResource()
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	movers := r.(fwresource.ResourceWithMoveState).MoveState(ctx)
+
+	move := func(sourceTypeName, sourceState string) fwresource.MoveStateResponse {
+		resp := fwresource.MoveStateResponse{
+			TargetState: tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			},
+		}
+		for _, mover := range movers {
+			mover.StateMover(ctx, fwresource.MoveStateRequest{
+				SourceTypeName: sourceTypeName,
+				SourceRawState: &tfprotov6.RawState{JSON: []byte(sourceState)},
+			}, &resp)
+		}
+		return resp
+	}
+	getString := func(resp *fwresource.MoveStateResponse, p path.Path) string {
+		var value types.String
+		resp.Diagnostics.Append(resp.TargetState.GetAttribute(ctx, p, &value)...)
+		return value.ValueString()
+	}
+
+	// One time downtimes
+	resp := move("datadog_downtime", `{
+		"id": "1234",
+		"scope": ["env:staging", "host:foo"],
+		"start": 1735707600,
+		"end": 1735711200,
+		"timezone": "Europe/Paris",
+		"message": "moved downtime ",
+		"monitor_id": null,
+		"monitor_tags": ["cat:hat"],
+		"mute_first_recovery_notification": true,
+		"recurrence": []
+	}`)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error moving the state: %v", resp.Diagnostics)
+	}
+	for attribute, expected := range map[string]string{
+		"id":                      "1234",
+		"scope":                   "env:staging AND host:foo",
+		"message":                 "moved downtime",
+		"display_timezone":        "Europe/Paris",
+		"one_time_schedule.start": "2025-01-01T05:00:00Z",
+		"one_time_schedule.end":   "2025-01-01T06:00:00Z",
+	} {
+		p := path.Root(strings.Split(attribute, ".")[0])
+		if parts := strings.Split(attribute, "."); len(parts) == 2 {
+			p = p.AtName(parts[1])
+		}
+		if actual := getString(&resp, p); actual != expected {
+			t.Errorf("expected %s to be %q, got %q", attribute, expected, actual)
+		}
+	}
+	var tags types.Set
+	resp.Diagnostics.Append(resp.TargetState.GetAttribute(ctx, path.Root("monitor_identifier").AtName("monitor_tags"), &tags)...)
+	if len(tags.Elements()) != 1 || tags.Elements()[0].(types.String).ValueString() != "cat:hat" {
+		t.Errorf("unexpected moved monitor tags %s", tags)
+	}
+
+	// Recurring downtimes, for each recurrence type
+	recurrences := map[string]string{
+		`{"type": "days", "period": 3, "until_occurrences": 4}`:                                  "FREQ=DAILY;INTERVAL=3;COUNT=4",
+		`{"type": "weeks", "period": 1, "week_days": ["Mon", "Fri"], "until_date": 1767225600}`:  "FREQ=WEEKLY;INTERVAL=1;BYDAY=MO,FR;UNTIL=20260101T000000Z",
+		`{"type": "months", "period": 2}`:                                                        "FREQ=MONTHLY;INTERVAL=2",
+		`{"type": "years", "period": 1}`:                                                         "FREQ=YEARLY;INTERVAL=1",
+		`{"type": "rrule", "period": 0, "rrule": "FREQ=MONTHLY;BYSETPOS=3;BYDAY=WE;INTERVAL=1"}`: "FREQ=MONTHLY;BYSETPOS=3;BYDAY=WE;INTERVAL=1",
+	}
+	for recurrence, expected := range recurrences {
+		resp = move("datadog_downtime", fmt.Sprintf(`{
+			"id": "1234",
+			"scope": ["*"],
+			"start": 1735707600,
+			"end": 1735794000,
+			"timezone": "Europe/Paris",
+			"monitor_id": 42,
+			"monitor_tags": [],
+			"recurrence": [%s]
+		}`, recurrence))
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error moving the state with recurrence %s: %v", recurrence, resp.Diagnostics)
+		}
+		recurrencePath := path.Root("recurring_schedule").AtName("recurrence").AtListIndex(0)
+		for p, expected := range map[string]string{
+			"rrule":    expected,
+			"start":    "2025-01-01T06:00:00",
+			"duration": "1d",
+		} {
+			if actual := getString(&resp, recurrencePath.AtName(p)); actual != expected {
+				t.Errorf("expected %s of recurrence %s to be %q, got %q", p, recurrence, expected, actual)
+			}
+		}
+		if actual := getString(&resp, path.Root("recurring_schedule").AtName("timezone")); actual != "Europe/Paris" {
+			t.Errorf("expected the timezone of recurrence %s to be moved, got %q", recurrence, actual)
+		}
+		var monitorId types.Int64
+		resp.Diagnostics.Append(resp.TargetState.GetAttribute(ctx, path.Root("monitor_identifier").AtName("monitor_id"), &monitorId)...)
+		if monitorId.ValueInt64() != 42 || getString(&resp, path.Root("scope")) != "*" {
+			t.Errorf("unexpected moved monitor %s and scope %s", monitorId, getString(&resp, path.Root("scope")))
+		}
+	}
+
+	// Recurring downtimes need an end to define the duration of their occurrences
+	resp = move("datadog_downtime", `{"id": "1234", "scope": ["*"], "start": 1735707600, "recurrence": [{"type": "days", "period": 1}]}`)
+	if !resp.Diagnostics.HasError() {
+		t.Errorf("expected an error moving a recurring downtime without end")
+	}
+
+	// Other resources are ignored
+	resp = move("datadog_monitor", `{"id": "123"}`)
+	if resp.Diagnostics.HasError() || !resp.TargetState.Raw.IsNull() {
+		t.Errorf("expected other resources to be ignored")
+	}
+}
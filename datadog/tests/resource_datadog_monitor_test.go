@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/terraform-providers/terraform-provider-datadog/datadog"
@@ -1118,12 +1120,31 @@ func TestAccDatadogMonitor_SchedulingOptionsCustomSchedule(t *testing.T) {
 						"datadog_monitor.foo", "scheduling_options.0.custom_schedule.0.recurrence.0.timezone", "America/New_York"),
 					resource.TestCheckResourceAttr(
 						"datadog_monitor.foo", "scheduling_options.0.custom_schedule.0.recurrence.0.start", "2023-11-10T12:31:00"),
+					resource.TestCheckResourceAttr(
+						"datadog_monitor.foo", "scheduling_options.0.custom_schedule.0.recurrence.0.next_occurrences.#", "5"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccDatadogMonitor_SchedulingOptionsCustomScheduleInvalidRrule(t *testing.T) {
+	t.Parallel()
+	ctx, accProviders := testAccProviders(context.Background(), t)
+	monitorName := uniqueEntityName(ctx, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: accProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      strings.ReplaceAll(testAccCheckDatadogMonitorWithSchedulingOptionsCustomSchedule(monitorName), "FREQ=DAILY;INTERVAL=1", "FREQ=HOURLY;INTERVAL=1"),
+				ExpectError: regexp.MustCompile("FREQ `HOURLY` is not supported for monitor custom schedules"),
+			},
+		},
+	})
+}
+
 func testAccCheckDatadogMonitorWithSchedulingOptionsCustomSchedule(uniq string) string {
 	return fmt.Sprintf(`
 resource "datadog_monitor" "foo" {
@@ -5,6 +5,7 @@ import (
 	_ "embed"
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -95,6 +96,104 @@ func TestAccOnCallScheduleCreateAndUpdate(t *testing.T) {
 	})
 }
 
+func TestAccOnCallScheduleCoverage(t *testing.T) {
+	t.Parallel()
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	uniq := strings.ToLower(uniqueEntityName(ctx, t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testAccCheckDatadogOnCallScheduleDestroy(providers.frameworkProvider),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogOnCallScheduleCoverage(uniq, false, onCallScheduleWeekendLayer),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogOnCallScheduleExists(providers.frameworkProvider),
+					resource.TestCheckResourceAttr("datadog_on_call_schedule.coverage", "coverage_gaps.#", "3"),
+					resource.TestCheckResourceAttr("datadog_on_call_schedule.coverage", "coverage_gaps.0.start", "2050-01-03T00:00:00+01:00"),
+					resource.TestCheckResourceAttr("datadog_on_call_schedule.coverage", "coverage_gaps.0.end", "2050-01-03T09:00:00+01:00"),
+					resource.TestCheckResourceAttr("datadog_on_call_schedule.coverage", "coverage_gaps.1.start", "2050-01-07T17:00:00+01:00"),
+					resource.TestCheckResourceAttr("datadog_on_call_schedule.coverage", "coverage_gaps.1.end", "2050-01-08T00:00:00+01:00"),
+					// The second member of the weekend rotation is nobody
+					resource.TestCheckResourceAttr("datadog_on_call_schedule.coverage", "coverage_gaps.2.start", "2050-01-09T00:00:00+01:00"),
+					resource.TestCheckResourceAttr("datadog_on_call_schedule.coverage", "coverage_gaps.2.end", "2050-01-10T00:00:00+01:00"),
+				),
+			},
+			{
+				Config:      testAccCheckDatadogOnCallScheduleCoverage(uniq, true, onCallScheduleWeekendLayer),
+				ExpectError: regexp.MustCompile("on-call schedule has coverage gaps"),
+			},
+			{
+				Config: testAccCheckDatadogOnCallScheduleCoverage(uniq, true, onCallScheduleOffHoursLayer),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogOnCallScheduleExists(providers.frameworkProvider),
+					resource.TestCheckResourceAttr("datadog_on_call_schedule.coverage", "require_full_coverage", "true"),
+					resource.TestCheckResourceAttr("datadog_on_call_schedule.coverage", "coverage_gaps.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const onCallScheduleWeekendLayer = `
+  layer {
+    name           = "Weekend"
+    effective_date = "2050-01-08T00:00:00+01:00"
+    rotation_start = "2050-01-08T00:00:00+01:00"
+    interval {
+      days = 1
+    }
+    users = [datadog_user.foo.id, null]
+  }`
+
+const onCallScheduleOffHoursLayer = `
+  layer {
+    name           = "Off hours"
+    effective_date = "2050-01-03T00:00:00+01:00"
+    rotation_start = "2050-01-03T00:00:00+01:00"
+    interval {
+      days = 7
+    }
+    users = [datadog_user.foo.id]
+    restriction {
+      start_day  = "friday"
+      start_time = "17:00:00"
+      end_day    = "monday"
+      end_time   = "09:00:00"
+    }
+  }`
+
+func testAccCheckDatadogOnCallScheduleCoverage(uniq string, requireFullCoverage bool, layer string) string {
+	return fmt.Sprintf(`
+resource "datadog_user" "foo" {
+  email                = "%[1]s@example.com"
+  send_user_invitation = false
+}
+
+resource "datadog_on_call_schedule" "coverage" {
+  name                  = "%[1]s"
+  time_zone             = "Europe/Paris"
+  coverage_horizon_days = 7
+  require_full_coverage = %[2]t
+  layer {
+    name           = "Business hours"
+    effective_date = "2050-01-03T00:00:00+01:00"
+    rotation_start = "2050-01-03T00:00:00+01:00"
+    interval {
+      days = 1
+    }
+    users = [datadog_user.foo.id]
+    restriction {
+      start_day  = "monday"
+      start_time = "09:00:00"
+      end_day    = "friday"
+      end_time   = "17:00:00"
+    }
+  }
+%[3]s
+}`, uniq, requireFullCoverage, layer)
+}
+
 func testAccCheckDatadogOnCallScheduleDestroy(accProvider *fwprovider.FrameworkProvider) func(*terraform.State) error {
 	return func(s *terraform.State) error {
 		apiInstances := accProvider.DatadogApiInstances
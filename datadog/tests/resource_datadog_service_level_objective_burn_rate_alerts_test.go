@@ -0,0 +1,214 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/fwprovider"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+)
+
+func TestAccDatadogServiceLevelObjectiveBurnRateAlerts_Basic(t *testing.T) {
+	t.Parallel()
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	uniq := uniqueEntityName(ctx, t)
+
+	policy := `
+  policy   = "google_sre"
+  priority = 2
+  tags     = ["team:checkout"]`
+	alerts := `
+  alert {
+    name         = "fast"
+    long_window  = "1h"
+    short_window = "5m"
+    threshold    = 10
+  }`
+	query := func(timeframe string, longWindow string, shortWindow string, threshold string) resource.CheckResourceAttrWithFunc {
+		return func(value string) error {
+			expected := fmt.Sprintf(`").over("%s").long_window("%s").short_window("%s") > %s`, timeframe, longWindow, shortWindow, threshold)
+			if !strings.HasPrefix(value, `burn_rate("`) || !strings.HasSuffix(value, expected) {
+				return fmt.Errorf("unexpected query %s", value)
+			}
+			return nil
+		}
+	}
+
+	var slowMonitorID int64
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testAccCheckDatadogServiceLevelObjectiveBurnRateAlertsDestroy(providers.frameworkProvider),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogServiceLevelObjectiveBurnRateAlertsConfig(uniq, "30d", 99.9, policy),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("datadog_service_level_objective_burn_rate_alerts.checkout", "id", "datadog_service_level_objective.checkout", "id"),
+					resource.TestCheckResourceAttr("datadog_service_level_objective_burn_rate_alerts.checkout", "monitors.#", "2"),
+					resource.TestCheckResourceAttr("datadog_service_level_objective_burn_rate_alerts.checkout", "monitors.0.name", uniq+" - fast burn rate"),
+					resource.TestCheckResourceAttrWith("datadog_service_level_objective_burn_rate_alerts.checkout", "monitors.0.query", query("30d", "1h", "5m", "14.4")),
+					resource.TestCheckResourceAttr("datadog_service_level_objective_burn_rate_alerts.checkout", "monitors.1.name", uniq+" - slow burn rate"),
+					resource.TestCheckResourceAttrWith("datadog_service_level_objective_burn_rate_alerts.checkout", "monitors.1.query", query("30d", "6h", "30m", "6")),
+					resource.TestCheckResourceAttr("datadog_service_level_objective_burn_rate_alerts.checkout", "priority", "2"),
+					resource.TestCheckResourceAttr("datadog_service_level_objective_burn_rate_alerts.checkout", "tags.#", "1"),
+					func(s *terraform.State) error {
+						slowMonitorID, _ = strconv.ParseInt(s.RootModule().Resources["datadog_service_level_objective_burn_rate_alerts.checkout"].Primary.Attributes["monitors.1.id"], 10, 64)
+						return nil
+					},
+				),
+			},
+			{
+				// A change to any of the monitors is planned back, not only to the first one
+				PreConfig: func() {
+					update := datadogV1.MonitorUpdateRequest{Message: datadog.PtrString("Changed outside of Terraform")}
+					if _, _, err := providers.frameworkProvider.DatadogApiInstances.GetMonitorsApiV1().UpdateMonitor(providers.frameworkProvider.Auth, slowMonitorID, update); err != nil {
+						t.Fatalf("Failed to update monitor: %v", err)
+					}
+				},
+				Config:             testAccCheckDatadogServiceLevelObjectiveBurnRateAlertsConfig(uniq, "30d", 99.9, policy),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCheckDatadogServiceLevelObjectiveBurnRateAlertsConfig(uniq, "30d", 99.9, policy),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("datadog_service_level_objective_burn_rate_alerts.checkout", "message", "Checkout is burning its error budget @pagerduty-checkout"),
+				),
+			},
+			{
+				ResourceName:      "datadog_service_level_objective_burn_rate_alerts.checkout",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Replacing the policy with a single alert updates the first monitor and deletes the second one
+				Config: testAccCheckDatadogServiceLevelObjectiveBurnRateAlertsConfig(uniq, "30d", 99.9, alerts),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("datadog_service_level_objective_burn_rate_alerts.checkout", "monitors.#", "1"),
+					resource.TestCheckResourceAttrWith("datadog_service_level_objective_burn_rate_alerts.checkout", "monitors.0.query", query("30d", "1h", "5m", "10")),
+					resource.TestCheckNoResourceAttr("datadog_service_level_objective_burn_rate_alerts.checkout", "priority"),
+				),
+			},
+			{
+				// The SLO is only read at plan time, so the monitors follow its new timeframe on the next apply
+				Config:             testAccCheckDatadogServiceLevelObjectiveBurnRateAlertsConfig(uniq, "7d", 99.5, alerts),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCheckDatadogServiceLevelObjectiveBurnRateAlertsConfig(uniq, "7d", 99.5, alerts),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("datadog_service_level_objective_burn_rate_alerts.checkout", "monitors.#", "1"),
+					resource.TestCheckResourceAttrWith("datadog_service_level_objective_burn_rate_alerts.checkout", "monitors.0.query", query("7d", "1h", "5m", "10")),
+				),
+			},
+			{
+				Config: testAccCheckDatadogServiceLevelObjectiveBurnRateAlertsConfig(uniq, "7d", 99.5, `
+  alert {
+    name         = "fast"
+    long_window  = "8d"
+    short_window = "1h"
+    threshold    = 250
+  }`),
+				ExpectError: regexp.MustCompile(`(?s)must be shorter than the 7d timeframe.*must be between 0 and 200`),
+			},
+		},
+	})
+}
+
+func TestAccDatadogServiceLevelObjectiveBurnRateAlerts_InvalidWindows(t *testing.T) {
+	t.Parallel()
+	_, _, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "datadog_service_level_objective_burn_rate_alerts" "checkout" {
+  slo_id  = "abc"
+  message = "Burning"
+  alert {
+    name         = "fast"
+    long_window  = "5m"
+    short_window = "1h"
+    threshold    = 14.4
+  }
+}`,
+				ExpectError: regexp.MustCompile("`short_window` \\(1h\\) must be shorter than `long_window` \\(5m\\)"),
+			},
+			{
+				Config: `
+resource "datadog_service_level_objective_burn_rate_alerts" "checkout" {
+  slo_id  = "abc"
+  message = "Burning"
+}`,
+				ExpectError: regexp.MustCompile("exactly one of `policy` or `alert` must be set"),
+			},
+		},
+	})
+}
+
+func testAccCheckDatadogServiceLevelObjectiveBurnRateAlertsConfig(uniq string, timeframe string, target float64, alerts string) string {
+	return fmt.Sprintf(`
+resource "datadog_service_level_objective" "checkout" {
+  name = "%s"
+  metric {
+    numerator   = "sum:checkout.requests{status:ok}.as_count()"
+    denominator = "sum:checkout.requests{*}.as_count()"
+  }
+  thresholds {
+    timeframe = "%s"
+    target    = %v
+  }
+}
+
+resource "datadog_service_level_objective_burn_rate_alerts" "checkout" {
+  slo_id  = datadog_service_level_objective.checkout.id
+  message = "Checkout is burning its error budget @pagerduty-checkout"
+%s
+}`, uniq, timeframe, target, alerts)
+}
+
+func testAccCheckDatadogServiceLevelObjectiveBurnRateAlertsDestroy(accProvider *fwprovider.FrameworkProvider) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		apiInstances := accProvider.DatadogApiInstances
+		auth := accProvider.Auth
+
+		if err := destroyServiceLevelObjectiveHelper(auth, s, apiInstances); err != nil {
+			return err
+		}
+		for _, r := range s.RootModule().Resources {
+			if r.Type != "datadog_service_level_objective_burn_rate_alerts" {
+				continue
+			}
+			count, _ := strconv.Atoi(r.Primary.Attributes["monitors.#"])
+			for i := 0; i < count; i++ {
+				id, _ := strconv.ParseInt(r.Primary.Attributes[fmt.Sprintf("monitors.%d.id", i)], 10, 64)
+				err := utils.Retry(2, 10, func() error {
+					_, httpResp, err := apiInstances.GetMonitorsApiV1().GetMonitor(auth, id)
+					if err != nil {
+						if httpResp != nil && httpResp.StatusCode == 404 {
+							return nil
+						}
+						return &utils.RetryableError{Prob: fmt.Sprintf("received an error retrieving monitor %s", err)}
+					}
+					return &utils.RetryableError{Prob: "Monitor still exists"}
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+}
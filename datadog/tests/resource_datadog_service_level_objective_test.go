@@ -6,12 +6,17 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/terraform-providers/terraform-provider-datadog/datadog"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/fwprovider"
 	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 // config
@@ -19,9 +24,8 @@ func testAccCheckDatadogServiceLevelObjectiveConfig(uniq string) string {
 	return fmt.Sprintf(`
 resource "datadog_service_level_objective" "foo" {
   name = "%s"
-  type = "metric"
   description = "some description about foo SLO"
-  query {
+  metric {
 	numerator = "sum:my.metric{type:good}.as_count()"
 	denominator = "sum:my.metric{*}.as_count()"
   }
@@ -57,9 +61,8 @@ func testAccCheckDatadogServiceLevelObjectiveConfigDuplicateTag(uniq string) str
 	return fmt.Sprintf(`
 resource "datadog_service_level_objective" "foo" {
   name = "%s"
-  type = "metric"
   description = "some description about foo SLO"
-  query {
+  metric {
 	numerator = "sum:my.metric{type:good}.as_count()"
 	denominator = "sum:my.metric{*}.as_count()"
   }
@@ -95,9 +98,8 @@ func testAccCheckDatadogServiceLevelObjectiveConfigNoTag(uniq string) string {
 	return fmt.Sprintf(`
 resource "datadog_service_level_objective" "foo" {
   name = "%s"
-  type = "metric"
   description = "some description about foo SLO"
-  query {
+  metric {
 	numerator = "sum:my.metric{type:good}.as_count()"
 	denominator = "sum:my.metric{*}.as_count()"
   }
@@ -131,31 +133,28 @@ func testAccCheckDatadogServiceLevelObjectiveTimeSliceConfig(uniq string) string
 	return fmt.Sprintf(`
 resource "datadog_service_level_objective" "foo" {
   name = "%s"
-  type = "time_slice"
   description = "some description about foo SLO"
-  sli_specification {
-    time_slice {
+  time_slice {
+    query {
+      formula {
+        formula_expression = "(query1-query2)/query1"
+      }
       query {
-        formula {
-          formula_expression = "(query1-query2)/query1"
-        }
-        query {
-          metric_query {
-            name        = "query1"
-            query       = "sum:trace.grpc.server.hits{service:monitor-history-reader}"
-		  }
+        metric_query {
+          name  = "query1"
+          query = "sum:trace.grpc.server.hits{service:monitor-history-reader}"
         }
-        query {
-          metric_query {
-            name        = "query2"
-            data_source = "metrics"
-            query       = "sum:trace.grpc.server.errors{service:monitor-history-reader}"
-		  }
+      }
+      query {
+        metric_query {
+          name        = "query2"
+          data_source = "metrics"
+          query       = "sum:trace.grpc.server.errors{service:monitor-history-reader}"
         }
       }
-      comparator = ">"
-      threshold  = 0.99
     }
+    comparator = ">"
+    threshold  = 0.99
   }
 
   thresholds {
@@ -178,9 +177,10 @@ func testAccCheckDatadogServiceLevelObjectiveInvalidMonitorConfig(uniq string) s
 	return fmt.Sprintf(`
 resource "datadog_service_level_objective" "bar" {
 	name               = "%s"
-	type               = "monitor"
 	description        = "My custom monitor SLO"
-	monitor_ids = [1, 2, 3]
+	monitor {
+	  monitor_ids = [1, 2, 3]
+	}
 	validate = true
 	thresholds {
 	timeframe = "7d"
@@ -202,9 +202,8 @@ func testAccCheckDatadogServiceLevelObjectiveConfigUpdated(uniq string) string {
 	return fmt.Sprintf(`
 resource "datadog_service_level_objective" "foo" {
   name = "%s"
-  type = "metric"
   description = "some updated description about foo SLO"
-  query {
+  metric {
 	numerator = "sum:my.metric{type:good}.as_count()"
 	denominator = "sum:my.metric{type:good}.as_count() + sum:my.metric{type:bad}.as_count()"
   }
@@ -240,20 +239,19 @@ resource "datadog_service_level_objective" "foo" {
 
 func TestAccDatadogServiceLevelObjective_Basic(t *testing.T) {
 	t.Parallel()
-	ctx, accProviders := testAccProviders(context.Background(), t)
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
 	sloName := uniqueEntityName(ctx, t)
 	sloNameUpdated := sloName + "-updated"
-	accProvider := testAccProvider(t, accProviders)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: accProviders,
-		CheckDestroy:      testAccCheckDatadogServiceLevelObjectiveDestroy(accProvider),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testAccCheckDatadogServiceLevelObjectiveDestroy(providers.frameworkProvider),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckDatadogServiceLevelObjectiveConfig(sloName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDatadogServiceLevelObjectiveExists(accProvider, "datadog_service_level_objective.foo"),
+					testAccCheckDatadogServiceLevelObjectiveExists(providers.frameworkProvider, "datadog_service_level_objective.foo"),
 					resource.TestCheckResourceAttr(
 						"datadog_service_level_objective.foo", "name", sloName),
 					resource.TestCheckResourceAttr(
@@ -261,9 +259,9 @@ func TestAccDatadogServiceLevelObjective_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"datadog_service_level_objective.foo", "type", "metric"),
 					resource.TestCheckResourceAttr(
-						"datadog_service_level_objective.foo", "query.0.numerator", "sum:my.metric{type:good}.as_count()"),
+						"datadog_service_level_objective.foo", "metric.0.numerator", "sum:my.metric{type:good}.as_count()"),
 					resource.TestCheckResourceAttr(
-						"datadog_service_level_objective.foo", "query.0.denominator", "sum:my.metric{*}.as_count()"),
+						"datadog_service_level_objective.foo", "metric.0.denominator", "sum:my.metric{*}.as_count()"),
 					// Thresholds are a TypeList, that are sorted by timeframe alphabetically.
 					resource.TestCheckResourceAttr(
 						"datadog_service_level_objective.foo", "thresholds.#", "3"),
@@ -300,7 +298,7 @@ func TestAccDatadogServiceLevelObjective_Basic(t *testing.T) {
 			{
 				Config: testAccCheckDatadogServiceLevelObjectiveConfigUpdated(sloNameUpdated),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDatadogServiceLevelObjectiveExists(accProvider, "datadog_service_level_objective.foo"),
+					testAccCheckDatadogServiceLevelObjectiveExists(providers.frameworkProvider, "datadog_service_level_objective.foo"),
 					resource.TestCheckResourceAttr(
 						"datadog_service_level_objective.foo", "name", sloNameUpdated),
 					resource.TestCheckResourceAttr(
@@ -308,9 +306,9 @@ func TestAccDatadogServiceLevelObjective_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"datadog_service_level_objective.foo", "type", "metric"),
 					resource.TestCheckResourceAttr(
-						"datadog_service_level_objective.foo", "query.0.numerator", "sum:my.metric{type:good}.as_count()"),
+						"datadog_service_level_objective.foo", "metric.0.numerator", "sum:my.metric{type:good}.as_count()"),
 					resource.TestCheckResourceAttr(
-						"datadog_service_level_objective.foo", "query.0.denominator", "sum:my.metric{type:good}.as_count() + sum:my.metric{type:bad}.as_count()"),
+						"datadog_service_level_objective.foo", "metric.0.denominator", "sum:my.metric{type:good}.as_count() + sum:my.metric{type:bad}.as_count()"),
 					// Thresholds are a TypeList, that are sorted by timeframe alphabetically.
 					resource.TestCheckResourceAttr(
 						"datadog_service_level_objective.foo", "thresholds.#", "3"),
@@ -350,14 +348,13 @@ func TestAccDatadogServiceLevelObjective_Basic(t *testing.T) {
 
 func TestAccDatadogServiceLevelObjective_InvalidMonitor(t *testing.T) {
 	t.Parallel()
-	ctx, accProviders := testAccProviders(context.Background(), t)
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
 	sloName := uniqueEntityName(ctx, t)
-	accProvider := testAccProvider(t, accProviders)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: accProviders,
-		CheckDestroy:      testAccCheckDatadogServiceLevelObjectiveDestroy(accProvider),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testAccCheckDatadogServiceLevelObjectiveDestroy(providers.frameworkProvider),
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccCheckDatadogServiceLevelObjectiveInvalidMonitorConfig(sloName),
@@ -369,19 +366,18 @@ func TestAccDatadogServiceLevelObjective_InvalidMonitor(t *testing.T) {
 
 func TestAccDatadogServiceLevelObjective_TimeSlice(t *testing.T) {
 	t.Parallel()
-	ctx, accProviders := testAccProviders(context.Background(), t)
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
 	sloName := uniqueEntityName(ctx, t)
-	accProvider := testAccProvider(t, accProviders)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: accProviders,
-		CheckDestroy:      testAccCheckDatadogServiceLevelObjectiveDestroy(accProvider),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testAccCheckDatadogServiceLevelObjectiveDestroy(providers.frameworkProvider),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckDatadogServiceLevelObjectiveTimeSliceConfig(sloName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDatadogServiceLevelObjectiveExists(accProvider, "datadog_service_level_objective.foo"),
+					testAccCheckDatadogServiceLevelObjectiveExists(providers.frameworkProvider, "datadog_service_level_objective.foo"),
 					resource.TestCheckResourceAttr(
 						"datadog_service_level_objective.foo", "name", sloName),
 					resource.TestCheckResourceAttr(
@@ -389,35 +385,33 @@ func TestAccDatadogServiceLevelObjective_TimeSlice(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"datadog_service_level_objective.foo", "type", "time_slice"),
 					resource.TestCheckResourceAttr(
-						"datadog_service_level_objective.foo", "sli_specification.#", "1"),
-					resource.TestCheckResourceAttr(
-						"datadog_service_level_objective.foo", "sli_specification.0.time_slice.#", "1"),
+						"datadog_service_level_objective.foo", "time_slice.#", "1"),
 					resource.TestCheckResourceAttr(
-						"datadog_service_level_objective.foo", "sli_specification.0.time_slice.0.query.#", "1"),
+						"datadog_service_level_objective.foo", "time_slice.0.query.#", "1"),
 					resource.TestCheckResourceAttr(
-						"datadog_service_level_objective.foo", "sli_specification.0.time_slice.0.query.0.formula.#", "1"),
+						"datadog_service_level_objective.foo", "time_slice.0.query.0.formula.#", "1"),
 					resource.TestCheckResourceAttr(
-						"datadog_service_level_objective.foo", "sli_specification.0.time_slice.0.query.0.formula.0.formula_expression", "(query1-query2)/query1"),
+						"datadog_service_level_objective.foo", "time_slice.0.query.0.formula.0.formula_expression", "(query1-query2)/query1"),
 					resource.TestCheckResourceAttr(
-						"datadog_service_level_objective.foo", "sli_specification.0.time_slice.0.query.0.query.#", "2"),
+						"datadog_service_level_objective.foo", "time_slice.0.query.0.query.#", "2"),
 					resource.TestCheckResourceAttr(
-						"datadog_service_level_objective.foo", "sli_specification.0.time_slice.0.query.0.query.0.metric_query.#", "1"),
+						"datadog_service_level_objective.foo", "time_slice.0.query.0.query.0.metric_query.#", "1"),
 					resource.TestCheckResourceAttr(
-						"datadog_service_level_objective.foo", "sli_specification.0.time_slice.0.query.0.query.0.metric_query.0.name", "query1"),
+						"datadog_service_level_objective.foo", "time_slice.0.query.0.query.0.metric_query.0.name", "query1"),
 					resource.TestCheckResourceAttr(
-						"datadog_service_level_objective.foo", "sli_specification.0.time_slice.0.query.0.query.0.metric_query.0.data_source", "metrics"),
+						"datadog_service_level_objective.foo", "time_slice.0.query.0.query.0.metric_query.0.data_source", "metrics"),
 					resource.TestCheckResourceAttr(
-						"datadog_service_level_objective.foo", "sli_specification.0.time_slice.0.query.0.query.0.metric_query.0.query", "sum:trace.grpc.server.hits{service:monitor-history-reader}"),
+						"datadog_service_level_objective.foo", "time_slice.0.query.0.query.0.metric_query.0.query", "sum:trace.grpc.server.hits{service:monitor-history-reader}"),
 					resource.TestCheckResourceAttr(
-						"datadog_service_level_objective.foo", "sli_specification.0.time_slice.0.query.0.query.1.metric_query.0.name", "query2"),
+						"datadog_service_level_objective.foo", "time_slice.0.query.0.query.1.metric_query.0.name", "query2"),
 					resource.TestCheckResourceAttr(
-						"datadog_service_level_objective.foo", "sli_specification.0.time_slice.0.query.0.query.1.metric_query.0.data_source", "metrics"),
+						"datadog_service_level_objective.foo", "time_slice.0.query.0.query.1.metric_query.0.data_source", "metrics"),
 					resource.TestCheckResourceAttr(
-						"datadog_service_level_objective.foo", "sli_specification.0.time_slice.0.query.0.query.1.metric_query.0.query", "sum:trace.grpc.server.errors{service:monitor-history-reader}"),
+						"datadog_service_level_objective.foo", "time_slice.0.query.0.query.1.metric_query.0.query", "sum:trace.grpc.server.errors{service:monitor-history-reader}"),
 					resource.TestCheckResourceAttr(
-						"datadog_service_level_objective.foo", "sli_specification.0.time_slice.0.comparator", ">"),
+						"datadog_service_level_objective.foo", "time_slice.0.comparator", ">"),
 					resource.TestCheckResourceAttr(
-						"datadog_service_level_objective.foo", "sli_specification.0.time_slice.0.threshold", "0.99"),
+						"datadog_service_level_objective.foo", "time_slice.0.threshold", "0.99"),
 					// Thresholds are a TypeList, that are sorted by timeframe alphabetically.
 					resource.TestCheckResourceAttr(
 						"datadog_service_level_objective.foo", "thresholds.#", "1"),
@@ -447,116 +441,121 @@ func TestAccDatadogServiceLevelObjective_TimeSlice(t *testing.T) {
 
 func TestAccDatadogServiceLevelObjective_DefaultTags(t *testing.T) {
 	t.Parallel()
-	ctx, accProviders := testAccProviders(context.Background(), t)
+	ctx, providers, _ := testAccFrameworkMuxProviders(context.Background(), t)
 	sloName := uniqueEntityName(ctx, t)
-	accProvider := testAccProvider(t, accProviders)
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() { testAccPreCheck(t) },
 		Steps: []resource.TestStep{
 			{ // New tags are correctly added and duplicates are kept
 				Config: testAccCheckDatadogServiceLevelObjectiveConfigDuplicateTag(sloName),
-				ProviderFactories: map[string]func() (*schema.Provider, error){
-					"datadog": withDefaultTags(accProvider, map[string]interface{}{
+				ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+					"datadog": withDefaultTagsFw(ctx, providers, map[string]string{
 						"default_key": "default_value",
 					}),
 				},
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
-						"datadog_service_level_objective.foo", "tags.#", "4"),
+						"datadog_service_level_objective.foo", "effective_tags.#", "4"),
 					resource.TestCheckTypeSetElemAttr(
-						"datadog_service_level_objective.foo", "tags.*", "baz"),
+						"datadog_service_level_objective.foo", "effective_tags.*", "baz"),
 					resource.TestCheckTypeSetElemAttr(
-						"datadog_service_level_objective.foo", "tags.*", "foo:bar"),
+						"datadog_service_level_objective.foo", "effective_tags.*", "foo:bar"),
 					resource.TestCheckTypeSetElemAttr(
-						"datadog_service_level_objective.foo", "tags.*", "foo:double_bar"),
+						"datadog_service_level_objective.foo", "effective_tags.*", "foo:double_bar"),
 					resource.TestCheckTypeSetElemAttr(
-						"datadog_service_level_objective.foo", "tags.*", "default_key:default_value"),
+						"datadog_service_level_objective.foo", "effective_tags.*", "default_key:default_value"),
 				),
 			},
 			{ // Resource tags take precedence over default tags and duplicates stay
 				Config: testAccCheckDatadogServiceLevelObjectiveConfigDuplicateTag(sloName),
-				ProviderFactories: map[string]func() (*schema.Provider, error){
-					"datadog": withDefaultTags(accProvider, map[string]interface{}{
+				ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+					"datadog": withDefaultTagsFw(ctx, providers, map[string]string{
 						"foo": "not_bar",
 					}),
 				},
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
-						"datadog_service_level_objective.foo", "tags.#", "3"),
+						"datadog_service_level_objective.foo", "effective_tags.#", "3"),
 					resource.TestCheckTypeSetElemAttr(
-						"datadog_service_level_objective.foo", "tags.*", "baz"),
+						"datadog_service_level_objective.foo", "effective_tags.*", "baz"),
 					resource.TestCheckTypeSetElemAttr(
-						"datadog_service_level_objective.foo", "tags.*", "foo:bar"),
+						"datadog_service_level_objective.foo", "effective_tags.*", "foo:bar"),
 					resource.TestCheckTypeSetElemAttr(
-						"datadog_service_level_objective.foo", "tags.*", "foo:double_bar"),
+						"datadog_service_level_objective.foo", "effective_tags.*", "foo:double_bar"),
 				),
 			},
 			{ // Resource tags take precedence over default tags, but new tags are added
 				Config: testAccCheckDatadogServiceLevelObjectiveConfig(sloName),
-				ProviderFactories: map[string]func() (*schema.Provider, error){
-					"datadog": withDefaultTags(accProvider, map[string]interface{}{
+				ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+					"datadog": withDefaultTagsFw(ctx, providers, map[string]string{
 						"foo":     "not_bar",
 						"new_tag": "new_value",
 					}),
 				},
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
-						"datadog_service_level_objective.foo", "tags.#", "3"),
+						"datadog_service_level_objective.foo", "effective_tags.#", "3"),
 					resource.TestCheckTypeSetElemAttr(
-						"datadog_service_level_objective.foo", "tags.*", "baz"),
+						"datadog_service_level_objective.foo", "effective_tags.*", "baz"),
 					resource.TestCheckTypeSetElemAttr(
-						"datadog_service_level_objective.foo", "tags.*", "foo:bar"),
+						"datadog_service_level_objective.foo", "effective_tags.*", "foo:bar"),
 					resource.TestCheckTypeSetElemAttr(
-						"datadog_service_level_objective.foo", "tags.*", "new_tag:new_value"),
+						"datadog_service_level_objective.foo", "effective_tags.*", "new_tag:new_value"),
 				),
 			},
 			{ // Tags without any value work correctly
 				Config: testAccCheckDatadogServiceLevelObjectiveConfig(sloName),
-				ProviderFactories: map[string]func() (*schema.Provider, error){
-					"datadog": withDefaultTags(accProvider, map[string]interface{}{
+				ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+					"datadog": withDefaultTagsFw(ctx, providers, map[string]string{
 						"no_value": "",
 					}),
 				},
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
-						"datadog_service_level_objective.foo", "tags.#", "3"),
+						"datadog_service_level_objective.foo", "effective_tags.#", "3"),
 					resource.TestCheckTypeSetElemAttr(
-						"datadog_service_level_objective.foo", "tags.*", "baz"),
+						"datadog_service_level_objective.foo", "effective_tags.*", "baz"),
 					resource.TestCheckTypeSetElemAttr(
-						"datadog_service_level_objective.foo", "tags.*", "foo:bar"),
+						"datadog_service_level_objective.foo", "effective_tags.*", "foo:bar"),
 					resource.TestCheckTypeSetElemAttr(
-						"datadog_service_level_objective.foo", "tags.*", "no_value"),
+						"datadog_service_level_objective.foo", "effective_tags.*", "no_value"),
 				),
 			},
 			{ // Tags with colons in the value work correctly
 				Config: testAccCheckDatadogServiceLevelObjectiveConfig(sloName),
-				ProviderFactories: map[string]func() (*schema.Provider, error){
-					"datadog": withDefaultTags(accProvider, map[string]interface{}{
+				ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+					"datadog": withDefaultTagsFw(ctx, providers, map[string]string{
 						"repo_url": "https://github.com/repo/path",
 					}),
 				},
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
-						"datadog_service_level_objective.foo", "tags.#", "3"),
+						"datadog_service_level_objective.foo", "effective_tags.#", "3"),
 					resource.TestCheckTypeSetElemAttr(
-						"datadog_service_level_objective.foo", "tags.*", "baz"),
+						"datadog_service_level_objective.foo", "effective_tags.*", "baz"),
 					resource.TestCheckTypeSetElemAttr(
-						"datadog_service_level_objective.foo", "tags.*", "foo:bar"),
+						"datadog_service_level_objective.foo", "effective_tags.*", "foo:bar"),
 					resource.TestCheckTypeSetElemAttr(
-						"datadog_service_level_objective.foo", "tags.*", "repo_url:https://github.com/repo/path"),
+						"datadog_service_level_objective.foo", "effective_tags.*", "repo_url:https://github.com/repo/path"),
 				),
 			},
-			{ // Works with monitors without a tag attribute
+			{ // Removing the tag attribute removes the tags of the SLO, only the default tags are kept
 				Config: testAccCheckDatadogServiceLevelObjectiveConfigNoTag(sloName),
-				ProviderFactories: map[string]func() (*schema.Provider, error){
-					"datadog": withDefaultTags(accProvider, map[string]interface{}{
+				ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+					"datadog": withDefaultTagsFw(ctx, providers, map[string]string{
 						"default_key": "default_value",
 					}),
 				},
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckTypeSetElemAttr(
-						"datadog_service_level_objective.foo", "tags.*", "default_key:default_value"),
-				),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("datadog_service_level_objective.foo", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("datadog_service_level_objective.foo", tfjsonpath.New("effective_tags"), knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("default_key:default_value"),
+						})),
+					},
+				},
 			},
 		},
 	})
@@ -564,12 +563,10 @@ func TestAccDatadogServiceLevelObjective_DefaultTags(t *testing.T) {
 
 // helpers
 
-func testAccCheckDatadogServiceLevelObjectiveDestroy(accProvider func() (*schema.Provider, error)) func(*terraform.State) error {
+func testAccCheckDatadogServiceLevelObjectiveDestroy(accProvider *fwprovider.FrameworkProvider) func(*terraform.State) error {
 	return func(s *terraform.State) error {
-		provider, _ := accProvider()
-		providerConf := provider.Meta().(*datadog.ProviderConfiguration)
-		apiInstances := providerConf.DatadogApiInstances
-		auth := providerConf.Auth
+		apiInstances := accProvider.DatadogApiInstances
+		auth := accProvider.Auth
 		if err := destroyServiceLevelObjectiveHelper(auth, s, apiInstances); err != nil {
 			return err
 		}
@@ -611,12 +608,10 @@ func existsServiceLevelObjectiveHelper(ctx context.Context, s *terraform.State,
 	return nil
 }
 
-func testAccCheckDatadogServiceLevelObjectiveExists(accProvider func() (*schema.Provider, error), n string) resource.TestCheckFunc {
+func testAccCheckDatadogServiceLevelObjectiveExists(accProvider *fwprovider.FrameworkProvider, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		provider, _ := accProvider()
-		providerConf := provider.Meta().(*datadog.ProviderConfiguration)
-		apiInstances := providerConf.DatadogApiInstances
-		auth := providerConf.Auth
+		apiInstances := accProvider.DatadogApiInstances
+		auth := accProvider.Auth
 
 		if err := existsServiceLevelObjectiveHelper(auth, s, apiInstances); err != nil {
 			return err
@@ -624,3 +619,185 @@ func testAccCheckDatadogServiceLevelObjectiveExists(accProvider func() (*schema.
 		return nil
 	}
 }
+
+func TestAccDatadogServiceLevelObjective_InvalidConfig(t *testing.T) {
+	t.Parallel()
+	_, _, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+
+	config := func(body string) string {
+		return fmt.Sprintf(`
+resource "datadog_service_level_objective" "foo" {
+  name = "invalid"
+%s
+}`, body)
+	}
+	metric := `
+  metric {
+    numerator   = "sum:my.metric{type:good}.as_count()"
+    denominator = "sum:my.metric{*}.as_count()"
+  }`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`
+  thresholds {
+    timeframe = "7d"
+    target    = 99
+  }`),
+				ExpectError: regexp.MustCompile("exactly one of the `metric`, `monitor` or `time_slice` blocks must be set"),
+			},
+			{
+				Config: config(metric + `
+  monitor {
+    monitor_ids = [1]
+  }
+  thresholds {
+    timeframe = "7d"
+    target    = 99
+  }`),
+				ExpectError: regexp.MustCompile("exactly one of the `metric`, `monitor` or `time_slice` blocks must be set"),
+			},
+			{
+				Config: config(`
+  monitor {
+    monitor_ids = [1, 2]
+    groups      = ["host:a"]
+  }
+  thresholds {
+    timeframe = "7d"
+    target    = 99
+  }`),
+				ExpectError: regexp.MustCompile("`groups` can only be used with a single monitor"),
+			},
+			{
+				Config: config(metric + `
+  type = "monitor"
+  thresholds {
+    timeframe = "7d"
+    target    = 99
+  }`),
+				ExpectError: regexp.MustCompile("`type` must be `metric` for a service level objective with a `metric` block"),
+			},
+			{
+				Config: config(metric + `
+  thresholds {
+    timeframe = "custom"
+    target    = 100
+    warning   = 99
+  }`),
+				ExpectError: regexp.MustCompile("(?s)only support the \\[7d 30d 90d\\] timeframes.*`target` must be between 0 and 100"),
+			},
+			{
+				Config: config(metric + `
+  thresholds {
+    timeframe = "7d"
+    target    = 99.5
+    warning   = 99
+  }
+  thresholds {
+    timeframe = "7d"
+    target    = 99
+  }`),
+				ExpectError: regexp.MustCompile("(?s)`warning` \\(99\\) must be greater than `target` \\(99.5\\).*the `7d` timeframe is used by more than one threshold"),
+			},
+			{
+				Config: config(metric + `
+  thresholds {
+    timeframe = "7d"
+    target    = 99
+  }
+  timeframe = "30d"`),
+				ExpectError: regexp.MustCompile("`timeframe` must be the timeframe of one of the `thresholds`, got `30d`"),
+			},
+			{
+				Config: config(metric + `
+  thresholds {
+    timeframe = "7d"
+    target    = 99
+  }
+  timeframe        = "7d"
+  target_threshold = 99.5`),
+				ExpectError: regexp.MustCompile("`target_threshold` \\(99.5\\) must match the target of the `7d` threshold \\(99\\)"),
+			},
+		},
+	})
+}
+
+func TestAccDatadogServiceLevelObjective_UnsupportedMonitor(t *testing.T) {
+	t.Parallel()
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	sloName := uniqueEntityName(ctx, t)
+
+	monitor := fmt.Sprintf(`
+resource "datadog_monitor" "logs" {
+  name    = "%s"
+  type    = "log alert"
+  message = "Too many errors"
+  query   = "logs(\"status:error\").index(\"*\").rollup(\"count\").last(\"5m\") > 100"
+}`, sloName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testAccCheckDatadogServiceLevelObjectiveDestroy(providers.frameworkProvider),
+		Steps: []resource.TestStep{
+			{
+				Config: monitor,
+			},
+			{
+				Config: monitor + fmt.Sprintf(`
+resource "datadog_service_level_objective" "bar" {
+  name = "%s"
+  monitor {
+    monitor_ids = [datadog_monitor.logs.id]
+  }
+  thresholds {
+    timeframe = "7d"
+    target    = 99.9
+  }
+}`, sloName),
+				ExpectError: regexp.MustCompile("is a `log alert` monitor, monitor SLOs only support"),
+			},
+		},
+	})
+}
+
+func TestServiceLevelObjectiveUpgradeStateV0(t *testing.T) {
+	t.Parallel()
+
+	r := fwprovider.NewServiceLevelObjectiveResource().(fwresource.ResourceWithUpgradeState)
+	upgrader := r.UpgradeState(context.Background())[0]
+
+	for name, tc := range map[string]struct {
+		prior    string
+		expected string
+	}{
+		"metric": {
+			prior:    `{"id":"abc","name":"foo","type":"metric","description":"","tags":["foo:bar"],"thresholds":[{"timeframe":"7d","target":99,"target_display":"99.","warning":null,"warning_display":""}],"query":[{"numerator":"sum:good","denominator":"sum:total"}],"monitor_ids":null,"groups":null,"sli_specification":[],"timeframe":"7d","target_threshold":99,"warning_threshold":null,"force_delete":null,"validate":null}`,
+			expected: `{"effective_tags":["foo:bar"],"force_delete":null,"id":"abc","metric":[{"denominator":"sum:total","numerator":"sum:good"}],"name":"foo","tags":["foo:bar"],"target_threshold":99,"thresholds":[{"target":99,"target_display":"99.","timeframe":"7d","warning":null,"warning_display":""}],"timeframe":"7d","type":"metric","validate":null,"warning_threshold":null}`,
+		},
+		"monitor": {
+			prior:    `{"id":"abc","name":"foo","type":"monitor","description":"bar","tags":[],"thresholds":[],"query":[],"monitor_ids":[1,2],"groups":["host:a"],"sli_specification":[],"timeframe":null,"target_threshold":null,"warning_threshold":null,"force_delete":true,"validate":false}`,
+			expected: `{"description":"bar","effective_tags":[],"force_delete":true,"id":"abc","monitor":[{"groups":["host:a"],"monitor_ids":[1,2]}],"name":"foo","target_threshold":null,"thresholds":[],"timeframe":null,"type":"monitor","validate":false,"warning_threshold":null}`,
+		},
+		"time_slice": {
+			prior:    `{"id":"abc","name":"foo","type":"time_slice","tags":[],"thresholds":[],"query":[],"monitor_ids":null,"groups":null,"sli_specification":[{"time_slice":[{"comparator":">","threshold":0.99,"query_interval_seconds":300,"query":[]}]}]}`,
+			expected: `{"effective_tags":[],"force_delete":null,"id":"abc","name":"foo","target_threshold":null,"thresholds":[],"time_slice":[{"comparator":"\u003e","query":[],"query_interval_seconds":300,"threshold":0.99}],"timeframe":null,"type":"time_slice","validate":null,"warning_threshold":null}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			req := fwresource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(tc.prior)}}
+			resp := &fwresource.UpgradeStateResponse{}
+			upgrader.StateUpgrader(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if string(resp.DynamicValue.JSON) != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, resp.DynamicValue.JSON)
+			}
+		})
+	}
+}
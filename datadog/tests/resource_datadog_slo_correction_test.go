@@ -3,31 +3,30 @@ package test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
-	"github.com/terraform-providers/terraform-provider-datadog/datadog"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/fwprovider"
 	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccDatadogSloCorrection_Basic(t *testing.T) {
 	t.Parallel()
-	ctx, accProviders := testAccProviders(context.Background(), t)
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
 	sloName := uniqueEntityName(ctx, t)
-	accProvider := testAccProvider(t, accProviders)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: accProviders,
-		CheckDestroy:      testAccCheckDatadogSloCorrectionDestroy(accProvider),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testAccCheckDatadogSloCorrectionDestroy(providers.frameworkProvider),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckDatadogSloCorrectionConfig(sloName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDatadogSloCorrectionExists(accProvider, "datadog_slo_correction.testing_slo_correction"),
+					testAccCheckDatadogSloCorrectionExists(providers.frameworkProvider, "datadog_slo_correction.testing_slo_correction"),
 					resource.TestCheckResourceAttr(
 						"datadog_slo_correction.testing_slo_correction", "description", "test correction on slo "+sloName),
 					resource.TestCheckResourceAttr(
@@ -46,19 +45,18 @@ func TestAccDatadogSloCorrection_Basic(t *testing.T) {
 
 func TestAccDatadogSloCorrection_Recurring(t *testing.T) {
 	t.Parallel()
-	ctx, accProviders := testAccProviders(context.Background(), t)
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
 	sloName := uniqueEntityName(ctx, t)
-	accProvider := testAccProvider(t, accProviders)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: accProviders,
-		CheckDestroy:      testAccCheckDatadogSloCorrectionDestroy(accProvider),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testAccCheckDatadogSloCorrectionDestroy(providers.frameworkProvider),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckDatadogSloCorrectionConfigRecurring(sloName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDatadogSloCorrectionExists(accProvider, "datadog_slo_correction.testing_slo_correction"),
+					testAccCheckDatadogSloCorrectionExists(providers.frameworkProvider, "datadog_slo_correction.testing_slo_correction"),
 					resource.TestCheckResourceAttr(
 						"datadog_slo_correction.testing_slo_correction", "description", "test correction on slo "+sloName),
 					resource.TestCheckResourceAttr(
@@ -79,19 +77,18 @@ func TestAccDatadogSloCorrection_Recurring(t *testing.T) {
 
 func TestAccDatadogSloCorrection_Updated(t *testing.T) {
 	t.Parallel()
-	ctx, accProviders := testAccProviders(context.Background(), t)
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
 	sloName := uniqueEntityName(ctx, t)
-	accProvider := testAccProvider(t, accProviders)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: accProviders,
-		CheckDestroy:      testAccCheckDatadogSloCorrectionDestroy(accProvider),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testAccCheckDatadogSloCorrectionDestroy(providers.frameworkProvider),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckDatadogSloCorrectionConfig(sloName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDatadogSloCorrectionExists(accProvider, "datadog_slo_correction.testing_slo_correction"),
+					testAccCheckDatadogSloCorrectionExists(providers.frameworkProvider, "datadog_slo_correction.testing_slo_correction"),
 					resource.TestCheckResourceAttr(
 						"datadog_slo_correction.testing_slo_correction", "description", "test correction on slo "+sloName),
 					resource.TestCheckResourceAttr(
@@ -107,7 +104,7 @@ func TestAccDatadogSloCorrection_Updated(t *testing.T) {
 			{
 				Config: testAccCheckDatadogSloCorrectionConfigUpdated(sloName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDatadogSloCorrectionExists(accProvider, "datadog_slo_correction.testing_slo_correction"),
+					testAccCheckDatadogSloCorrectionExists(providers.frameworkProvider, "datadog_slo_correction.testing_slo_correction"),
 					resource.TestCheckResourceAttr(
 						"datadog_slo_correction.testing_slo_correction", "description", "updated test correction - "+sloName),
 					resource.TestCheckResourceAttr(
@@ -124,13 +121,86 @@ func TestAccDatadogSloCorrection_Updated(t *testing.T) {
 	})
 }
 
+func TestAccDatadogSloCorrection_NextOccurrences(t *testing.T) {
+	t.Parallel()
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	sloName := uniqueEntityName(ctx, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testAccCheckDatadogSloCorrectionDestroy(providers.frameworkProvider),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogSloCorrectionConfigNextOccurrences(sloName, 1894294800),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogSloCorrectionExists(providers.frameworkProvider, "datadog_slo_correction.recurring"),
+					resource.TestCheckResourceAttr(
+						"datadog_slo_correction.recurring", "next_occurrences.#", "5"),
+					resource.TestCheckResourceAttr(
+						"datadog_slo_correction.recurring", "next_occurrences.0.start", "2030-01-07T09:00:00-05:00"),
+					resource.TestCheckResourceAttr(
+						"datadog_slo_correction.recurring", "next_occurrences.0.end", "2030-01-07T11:00:00-05:00"),
+					resource.TestCheckResourceAttr(
+						"datadog_slo_correction.recurring", "next_occurrences.1.start", "2030-01-10T09:00:00-05:00"),
+					resource.TestCheckResourceAttr(
+						"datadog_slo_correction.recurring", "next_occurrences.4.end", "2030-01-19T11:00:00-05:00"),
+					resource.TestCheckResourceAttr(
+						"datadog_slo_correction.once", "next_occurrences.#", "1"),
+					resource.TestCheckResourceAttr(
+						"datadog_slo_correction.once", "next_occurrences.0.start", "2030-01-10T15:00:00Z"),
+					resource.TestCheckResourceAttr(
+						"datadog_slo_correction.once", "next_occurrences.0.end", "2030-01-10T17:00:00Z"),
+				),
+			},
+			{
+				// The SLO is known at plan time now, so the overlap with the recurring correction is checked
+				Config: testAccCheckDatadogSloCorrectionConfigNextOccurrences(sloName, 1894298400),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"datadog_slo_correction.once", "next_occurrences.0.end", "2030-01-10T18:00:00Z"),
+					resource.TestCheckResourceAttr(
+						"datadog_slo_correction.recurring", "next_occurrences.#", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatadogSloCorrection_InvalidConfig(t *testing.T) {
+	t.Parallel()
+	_, _, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckDatadogSloCorrectionConfigInvalid(`rrule = "FREQ=WEEKLY;BYDAY=MO"`, `duration = 3600`),
+				ExpectError: regexp.MustCompile("`BYDAY` is not supported for SLO corrections"),
+			},
+			{
+				Config:      testAccCheckDatadogSloCorrectionConfigInvalid(`rrule = "FREQ=DAILY;COUNT=2;UNTIL=20300101"`, `duration = 3600`),
+				ExpectError: regexp.MustCompile("`COUNT` and `UNTIL` can't be used together"),
+			},
+			{
+				Config:      testAccCheckDatadogSloCorrectionConfigInvalid(`rrule = "FREQ=DAILY"`, ``),
+				ExpectError: regexp.MustCompile("`duration` is required when `rrule` is set"),
+			},
+			{
+				Config:      testAccCheckDatadogSloCorrectionConfigInvalid(`end = 1894020000`, ``),
+				ExpectError: regexp.MustCompile("`end` \\(1894020000\\) must be after `start` \\(1894024800\\)"),
+			},
+		},
+	})
+}
+
 func testAccCheckDatadogSloCorrectionConfig(uniq string) string {
 	return fmt.Sprintf(`
 		resource "datadog_service_level_objective" "foo" {
 			name = "%s"
-			type = "metric"
 			description = "some updated description about foo SLO"
-			query {
+			metric {
 			  numerator = "sum:my.metric{type:good}.as_count()"
 			  denominator = "sum:my.metric{type:good}.as_count() + sum:my.metric{type:bad}.as_count()"
 			}
@@ -169,9 +239,8 @@ func testAccCheckDatadogSloCorrectionConfigRecurring(uniq string) string {
 	return fmt.Sprintf(`
 	resource "datadog_service_level_objective" "foo" {
 			name = "%s"
-			type = "metric"
 			description = "some updated description about foo SLO"
-			query {
+			metric {
 			  numerator = "sum:my.metric{type:good}.as_count()"
 			  denominator = "sum:my.metric{type:good}.as_count() + sum:my.metric{type:bad}.as_count()"
 			}
@@ -211,9 +280,8 @@ func testAccCheckDatadogSloCorrectionConfigUpdated(uniq string) string {
 	return fmt.Sprintf(`
 		resource "datadog_service_level_objective" "foo" {
 			name = "%s"
-			type = "metric"
 			description = "some updated description about foo SLO"
-			query {
+			metric {
 			numerator = "sum:my.metric{type:good}.as_count()"
 			denominator = "sum:my.metric{type:good}.as_count() + sum:my.metric{type:bad}.as_count()"
 			}
@@ -248,12 +316,57 @@ func testAccCheckDatadogSloCorrectionConfigUpdated(uniq string) string {
     `, uniq, uniq)
 }
 
-func testAccCheckDatadogSloCorrectionExists(accProvider func() (*schema.Provider, error), resourceName string) resource.TestCheckFunc {
+func testAccCheckDatadogSloCorrectionConfigNextOccurrences(uniq string, end int) string {
+	return fmt.Sprintf(`
+		resource "datadog_service_level_objective" "foo" {
+			name = "%s"
+			metric {
+			  numerator = "sum:my.metric{type:good}.as_count()"
+			  denominator = "sum:my.metric{type:good}.as_count() + sum:my.metric{type:bad}.as_count()"
+			}
+
+			thresholds {
+			  timeframe = "7d"
+			  target = 99.5
+			}
+		}
+		resource "datadog_slo_correction" "recurring" {
+			category = "Scheduled Maintenance"
+			description = "recurring correction on slo %s"
+			slo_id = datadog_service_level_objective.foo.id
+			start = 1894024800
+			timezone = "America/New_York"
+			rrule = "RRULE:FREQ=DAILY;INTERVAL=3"
+			duration = 7200
+		}
+		resource "datadog_slo_correction" "once" {
+			depends_on = [datadog_slo_correction.recurring]
+			category = "Deployment"
+			description = "overlapping correction on slo %s"
+			slo_id = datadog_service_level_objective.foo.id
+			start = 1894287600
+			end = %d
+			timezone = "UTC"
+		}
+	`, uniq, uniq, uniq, end)
+}
+
+func testAccCheckDatadogSloCorrectionConfigInvalid(schedule string, duration string) string {
+	return fmt.Sprintf(`
+		resource "datadog_slo_correction" "invalid" {
+			category = "Scheduled Maintenance"
+			slo_id = "abc"
+			start = 1894024800
+			%s
+			%s
+		}
+	`, schedule, duration)
+}
+
+func testAccCheckDatadogSloCorrectionExists(accProvider *fwprovider.FrameworkProvider, resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		provider, _ := accProvider()
-		providerConf := provider.Meta().(*datadog.ProviderConfiguration)
-		apiInstances := providerConf.DatadogApiInstances
-		auth := providerConf.Auth
+		apiInstances := accProvider.DatadogApiInstances
+		auth := accProvider.Auth
 
 		for _, r := range s.RootModule().Resources {
 			if r.Type != "datadog_slo_correction" {
@@ -268,12 +381,10 @@ func testAccCheckDatadogSloCorrectionExists(accProvider func() (*schema.Provider
 	}
 }
 
-func testAccCheckDatadogSloCorrectionDestroy(accProvider func() (*schema.Provider, error)) func(*terraform.State) error {
+func testAccCheckDatadogSloCorrectionDestroy(accProvider *fwprovider.FrameworkProvider) func(*terraform.State) error {
 	return func(s *terraform.State) error {
-		provider, _ := accProvider()
-		providerConf := provider.Meta().(*datadog.ProviderConfiguration)
-		apiInstances := providerConf.DatadogApiInstances
-		auth := providerConf.Auth
+		apiInstances := accProvider.DatadogApiInstances
+		auth := accProvider.Auth
 		for _, r := range s.RootModule().Resources {
 			if r.Type != "datadog_slo_correction" {
 				continue
@@ -0,0 +1,321 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/fwprovider"
+)
+
+func TestAccDatadogSyntheticsAPITestResource_Basic(t *testing.T) {
+	t.Parallel()
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	uniq := uniqueEntityName(ctx, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testAccCheckDatadogSyntheticsTestKindDestroy(providers.frameworkProvider, "datadog_synthetics_api_test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogSyntheticsAPITestResourceConfig(uniq, "200"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogSyntheticsTestKindExists(providers.frameworkProvider, "datadog_synthetics_api_test.foo"),
+					resource.TestCheckResourceAttr("datadog_synthetics_api_test.foo", "name", uniq),
+					resource.TestCheckResourceAttr("datadog_synthetics_api_test.foo", "subtype", "http"),
+					resource.TestCheckResourceAttr("datadog_synthetics_api_test.foo", "request_definition.0.method", "GET"),
+					resource.TestCheckResourceAttr("datadog_synthetics_api_test.foo", "request_headers.Accept", "application/json"),
+					resource.TestCheckResourceAttr("datadog_synthetics_api_test.foo", "assertion.#", "2"),
+					resource.TestCheckResourceAttr("datadog_synthetics_api_test.foo", "assertion.1.target", "200"),
+					resource.TestCheckResourceAttr("datadog_synthetics_api_test.foo", "locations.#", "1"),
+					resource.TestCheckResourceAttr("datadog_synthetics_api_test.foo", "options_list.0.tick_every", "60"),
+					resource.TestCheckResourceAttr("datadog_synthetics_api_test.foo", "options_list.0.retry.0.count", "1"),
+					resource.TestCheckResourceAttr("datadog_synthetics_api_test.foo", "config_variable.0.name", "VARIABLE_NAME"),
+					resource.TestCheckResourceAttr("datadog_synthetics_api_test.foo", "message", "Notify @datadog.user"),
+					resource.TestCheckResourceAttr("datadog_synthetics_api_test.foo", "status", "paused"),
+					resource.TestCheckResourceAttrSet("datadog_synthetics_api_test.foo", "monitor_id"),
+				),
+			},
+			{
+				ResourceName:      "datadog_synthetics_api_test.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCheckDatadogSyntheticsAPITestResourceConfig(uniq+"-updated", "201"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogSyntheticsTestKindExists(providers.frameworkProvider, "datadog_synthetics_api_test.foo"),
+					resource.TestCheckResourceAttr("datadog_synthetics_api_test.foo", "name", uniq+"-updated"),
+					resource.TestCheckResourceAttr("datadog_synthetics_api_test.foo", "assertion.1.target", "201"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatadogSyntheticsAPITestResource_GRPCUnaryWithoutMessage(t *testing.T) {
+	t.Parallel()
+	_, _, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "datadog_synthetics_api_test" "grpc" {
+	name      = "grpc"
+	subtype   = "grpc"
+	status    = "paused"
+	locations = ["aws:eu-central-1"]
+	request_definition {
+		host      = "google.com"
+		port      = "50050"
+		call_type = "unary"
+		service   = "Hello"
+		method    = "SayHello"
+	}
+	assertion {
+		type     = "grpcHealthcheckStatus"
+		operator = "is"
+		target   = "1"
+	}
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("message is required for gRPC unary calls"),
+			},
+		},
+	})
+}
+
+func TestAccDatadogSyntheticsAPITestResource_MovedFromSyntheticsTest(t *testing.T) {
+	testAccDatadogSyntheticsTestKindMoved(t, "datadog_synthetics_api_test", `type = "api"`, func(name string) string {
+		return testAccCheckDatadogSyntheticsAPITestResourceConfig(name, "200")
+	})
+}
+
+// testAccDatadogSyntheticsTestKindMoved creates a `datadog_synthetics_test` resource with the configuration of a typed
+// resource, and checks that moving it to the typed resource with a `moved` block plans no changes.
+func testAccDatadogSyntheticsTestKindMoved(t *testing.T, resourceType, typeAttributes string, config func(name string) string) {
+	t.Parallel()
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	uniq := uniqueEntityName(ctx, t)
+	target := config(uniq)
+	source := strings.Replace(target, fmt.Sprintf(`resource "%s" "foo" {`, resourceType), `resource "datadog_synthetics_test" "foo" {
+	`+typeAttributes, 1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		CheckDestroy: testAccCheckDatadogSyntheticsTestKindDestroy(providers.frameworkProvider, resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: source,
+			},
+			{
+				Config: fmt.Sprintf(`
+moved {
+	from = datadog_synthetics_test.foo
+	to   = %s.foo
+}
+%s`, resourceType, target),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogSyntheticsTestKindExists(providers.frameworkProvider, resourceType+".foo"),
+					resource.TestCheckResourceAttr(resourceType+".foo", "name", uniq),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDatadogSyntheticsAPITestResourceConfig(name, statusCode string) string {
+	return fmt.Sprintf(`
+resource "datadog_synthetics_api_test" "foo" {
+	name    = "%s"
+	message = "Notify @datadog.user"
+	tags    = ["foo:bar", "baz"]
+	status  = "paused"
+
+	locations = ["aws:eu-central-1"]
+
+	request_definition {
+		method = "GET"
+		url    = "https://www.datadoghq.com"
+	}
+	request_headers = {
+		Accept = "application/json"
+	}
+
+	assertion {
+		type     = "header"
+		property = "content-type"
+		operator = "contains"
+		target   = "application/json"
+	}
+	assertion {
+		type     = "statusCode"
+		operator = "is"
+		target   = "%s"
+	}
+
+	options_list {
+		tick_every          = 60
+		min_location_failed = 1
+		retry {
+			count = 1
+		}
+	}
+
+	config_variable {
+		type    = "text"
+		name    = "VARIABLE_NAME"
+		pattern = "{{numeric(3)}}"
+		example = "123"
+	}
+}`, name, statusCode)
+}
+
+func testAccCheckDatadogSyntheticsTestKindExists(accProvider *fwprovider.FrameworkProvider, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id := s.RootModule().Resources[name].Primary.ID
+		if _, httpresp, err := accProvider.DatadogApiInstances.GetSyntheticsApiV1().GetTest(accProvider.Auth, id); err != nil {
+			return fmt.Errorf("received an error retrieving synthetics test %s: %s, %v", id, err, httpresp)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDatadogSyntheticsTestKindDestroy(accProvider *fwprovider.FrameworkProvider, resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, r := range s.RootModule().Resources {
+			if r.Type != resourceType {
+				continue
+			}
+			_, httpresp, err := accProvider.DatadogApiInstances.GetSyntheticsApiV1().GetTest(accProvider.Auth, r.Primary.ID)
+			if err != nil {
+				if httpresp != nil && httpresp.StatusCode == 404 {
+					continue
+				}
+				return fmt.Errorf("received an error retrieving synthetics test %s", err)
+			}
+			return fmt.Errorf("synthetics test %s still exists", r.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func TestSyntheticsAPITestResourceMoveState(t *testing.T) {
+	ctx := context.Background()
+	r := fwprovider.NewSyntheticsAPITestResource()
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	movers := r.(fwresource.ResourceWithMoveState).MoveState(ctx)
+
+	move := func(sourceTypeName, sourceState string) fwresource.MoveStateResponse {
+		resp := fwresource.MoveStateResponse{
+			TargetState: tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			},
+		}
+		for _, mover := range movers {
+			mover.StateMover(ctx, fwresource.MoveStateRequest{
+				SourceTypeName: sourceTypeName,
+				SourceRawState: &tfprotov6.RawState{JSON: []byte(sourceState)},
+			}, &resp)
+		}
+		return resp
+	}
+
+	// API tests created without a subtype are HTTP tests
+	resp := move("datadog_synthetics_test", `{
+		"id": "abc-def-ghi",
+		"type": "api",
+		"name": "moved test",
+		"status": "paused",
+		"locations": ["aws:eu-central-1"],
+		"tags": ["foo:bar"],
+		"device_ids": [],
+		"request_definition": [{"method": "GET", "url": "https://www.datadoghq.com", "timeout": 60}],
+		"request_headers": {"Accept": "application/json"},
+		"assertion": [{"type": "statusCode", "operator": "is", "target": "200"}],
+		"browser_step": []
+	}`)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error moving the state: %v", resp.Diagnostics)
+	}
+	for attribute, expected := range map[string]string{
+		"id":      "abc-def-ghi",
+		"name":    "moved test",
+		"subtype": "http",
+		"status":  "paused",
+	} {
+		var value types.String
+		resp.Diagnostics.Append(resp.TargetState.GetAttribute(ctx, path.Root(attribute), &value)...)
+		if value.ValueString() != expected {
+			t.Errorf("expected %s to be %q, got %q", attribute, expected, value.ValueString())
+		}
+	}
+	var method types.String
+	resp.Diagnostics.Append(resp.TargetState.GetAttribute(ctx, path.Root("request_definition").AtListIndex(0).AtName("method"), &method)...)
+	var timeout types.Int64
+	resp.Diagnostics.Append(resp.TargetState.GetAttribute(ctx, path.Root("request_definition").AtListIndex(0).AtName("timeout"), &timeout)...)
+	var header types.String
+	resp.Diagnostics.Append(resp.TargetState.GetAttribute(ctx, path.Root("request_headers").AtMapKey("Accept"), &header)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error reading the moved state: %v", resp.Diagnostics)
+	}
+	if method.ValueString() != "GET" || timeout.ValueInt64() != 60 || header.ValueString() != "application/json" {
+		t.Errorf("unexpected moved request: method %s, timeout %s, header %s", method, timeout, header)
+	}
+
+	// Tests using attributes which are only supported by the `datadog_synthetics_test` resource can't be moved
+	resp = move("datadog_synthetics_test", `{
+		"id": "abc-def-ghi",
+		"type": "api",
+		"subtype": "http",
+		"name": "authenticated test",
+		"request_basicauth": [{"type": "web", "username": "user", "password": "secret"}]
+	}`)
+	if !resp.Diagnostics.HasError() {
+		t.Errorf("expected an error moving a test with basic authentication")
+	}
+
+	// Tests of another kind can't be moved
+	resp = move("datadog_synthetics_test", `{"id": "abc-def-ghi", "type": "api", "subtype": "multi", "name": "multistep test"}`)
+	if !resp.Diagnostics.HasError() {
+		t.Errorf("expected an error moving a multistep test")
+	}
+	resp = move("datadog_synthetics_test", `{"id": "abc-def-ghi", "type": "browser", "name": "browser test"}`)
+	if !resp.Diagnostics.HasError() {
+		t.Errorf("expected an error moving a browser test")
+	}
+
+	// Other resources are ignored
+	resp = move("datadog_monitor", `{"id": "123"}`)
+	if resp.Diagnostics.HasError() || !resp.TargetState.Raw.IsNull() {
+		t.Errorf("expected other resources to be ignored")
+	}
+}
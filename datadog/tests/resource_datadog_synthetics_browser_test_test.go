@@ -0,0 +1,112 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatadogSyntheticsBrowserTestResource_Basic(t *testing.T) {
+	t.Parallel()
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	uniq := uniqueEntityName(ctx, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testAccCheckDatadogSyntheticsTestKindDestroy(providers.frameworkProvider, "datadog_synthetics_browser_test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogSyntheticsBrowserTestResourceConfig(uniq, "https://www.datadoghq.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogSyntheticsTestKindExists(providers.frameworkProvider, "datadog_synthetics_browser_test.foo"),
+					resource.TestCheckResourceAttr("datadog_synthetics_browser_test.foo", "name", uniq),
+					resource.TestCheckResourceAttr("datadog_synthetics_browser_test.foo", "request_definition.0.url", "https://www.datadoghq.com"),
+					resource.TestCheckResourceAttr("datadog_synthetics_browser_test.foo", "device_ids.#", "2"),
+					resource.TestCheckResourceAttr("datadog_synthetics_browser_test.foo", "options_list.0.retry.0.count", "2"),
+					resource.TestCheckResourceAttr("datadog_synthetics_browser_test.foo", "browser_step.#", "2"),
+					resource.TestCheckResourceAttr("datadog_synthetics_browser_test.foo", "browser_step.0.type", "assertCurrentUrl"),
+					resource.TestCheckResourceAttr("datadog_synthetics_browser_test.foo", "browser_step.0.params.0.value", "datadoghq"),
+					resource.TestCheckResourceAttr("datadog_synthetics_browser_test.foo", "browser_step.1.type", "wait"),
+					resource.TestCheckResourceAttr("datadog_synthetics_browser_test.foo", "browser_variable.0.name", "MY_PATTERN_VAR"),
+					resource.TestCheckResourceAttrSet("datadog_synthetics_browser_test.foo", "monitor_id"),
+				),
+			},
+			{
+				ResourceName:      "datadog_synthetics_browser_test.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The steps and options have values only set in the state
+				ImportStateVerifyIgnore: []string{"options_list", "browser_step"},
+			},
+			{
+				Config: testAccCheckDatadogSyntheticsBrowserTestResourceConfig(uniq, "https://docs.datadoghq.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogSyntheticsTestKindExists(providers.frameworkProvider, "datadog_synthetics_browser_test.foo"),
+					resource.TestCheckResourceAttr("datadog_synthetics_browser_test.foo", "request_definition.0.url", "https://docs.datadoghq.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatadogSyntheticsBrowserTestResource_MovedFromSyntheticsTest(t *testing.T) {
+	testAccDatadogSyntheticsTestKindMoved(t, "datadog_synthetics_browser_test", `type = "browser"`, func(name string) string {
+		return testAccCheckDatadogSyntheticsBrowserTestResourceConfig(name, "https://www.datadoghq.com")
+	})
+}
+
+func testAccCheckDatadogSyntheticsBrowserTestResourceConfig(name, url string) string {
+	return fmt.Sprintf(`
+resource "datadog_synthetics_browser_test" "foo" {
+	name      = "%s"
+	message   = "Notify @datadog.user"
+	tags      = ["foo:bar", "baz"]
+	status    = "paused"
+	locations = ["aws:eu-central-1"]
+
+	device_ids = ["laptop_large", "mobile_small"]
+
+	request_definition {
+		method = "GET"
+		url    = "%s"
+	}
+	request_headers = {
+		Accept = "application/json"
+	}
+
+	options_list {
+		tick_every          = 900
+		min_location_failed = 1
+		retry {
+			count    = 2
+			interval = 300
+		}
+	}
+
+	browser_step {
+		name = "first step"
+		type = "assertCurrentUrl"
+		params {
+			check = "contains"
+			value = "datadoghq"
+		}
+	}
+	browser_step {
+		name = "second step"
+		type = "wait"
+		params {
+			value = "1"
+		}
+	}
+
+	browser_variable {
+		type    = "text"
+		name    = "MY_PATTERN_VAR"
+		pattern = "{{numeric(3)}}"
+		example = "597"
+	}
+}`, name, url)
+}
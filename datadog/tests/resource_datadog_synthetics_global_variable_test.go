@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 
 	"github.com/terraform-providers/terraform-provider-datadog/datadog/fwprovider"
 )
@@ -33,6 +35,8 @@ func TestAccDatadogSyntheticsGlobalVariable_importBasic(t *testing.T) {
 				ResourceName:      "datadog_synthetics_global_variable.foo",
 				ImportState:       true,
 				ImportStateVerify: true,
+				// The rotation time is only known by Terraform
+				ImportStateVerifyIgnore: []string{"last_rotated_at"},
 			},
 		},
 	})
@@ -96,6 +100,72 @@ func TestAccDatadogSyntheticsGlobalVariableSecure_Updated(t *testing.T) {
 	})
 }
 
+func TestAccDatadogSyntheticsGlobalVariableWriteOnly(t *testing.T) {
+	t.Parallel()
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	variableName := getUniqueVariableName(ctx, t)
+	var lastRotatedAt string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testSyntheticsGlobalVariableResourceIsDestroyed(providers.frameworkProvider),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: createSyntheticsGlobalVariableWriteOnlyConfig(variableName, "foo:bar", "secret-1", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testSyntheticsGlobalVariableResourceExists(providers.frameworkProvider),
+					resource.TestCheckNoResourceAttr("datadog_synthetics_global_variable.foo", "value"),
+					resource.TestCheckNoResourceAttr("datadog_synthetics_global_variable.foo", "value_wo"),
+					resource.TestCheckResourceAttr("datadog_synthetics_global_variable.foo", "value_wo_version", "1"),
+					resource.TestCheckResourceAttr("datadog_synthetics_global_variable.foo", "secure", "true"),
+					resource.TestCheckResourceAttrWith("datadog_synthetics_global_variable.foo", "last_rotated_at", func(value string) error {
+						lastRotatedAt = value
+						_, err := time.Parse(time.RFC3339, value)
+						return err
+					}),
+				),
+			},
+			{
+				// Other changes keep the rotation time
+				Config: createSyntheticsGlobalVariableWriteOnlyConfig(variableName, "foo:baz", "secret-1", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("datadog_synthetics_global_variable.foo", "tags.0", "foo:baz"),
+					resource.TestCheckResourceAttrWith("datadog_synthetics_global_variable.foo", "last_rotated_at", func(value string) error {
+						if value != lastRotatedAt {
+							return fmt.Errorf("expected last_rotated_at to be %s, got %s", lastRotatedAt, value)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config: createSyntheticsGlobalVariableWriteOnlyConfig(variableName, "foo:baz", "secret-2", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("datadog_synthetics_global_variable.foo", "value_wo"),
+					resource.TestCheckResourceAttr("datadog_synthetics_global_variable.foo", "value_wo_version", "2"),
+					resource.TestCheckResourceAttrSet("datadog_synthetics_global_variable.foo", "last_rotated_at"),
+				),
+			},
+		},
+	})
+}
+
+func createSyntheticsGlobalVariableWriteOnlyConfig(uniq, tag, value string, version int) string {
+	return fmt.Sprintf(`
+resource "datadog_synthetics_global_variable" "foo" {
+	name             = "%s"
+	description      = "a write-only global variable"
+	tags             = ["%s"]
+	value_wo         = "%s"
+	value_wo_version = %d
+	secure           = true
+}`, uniq, tag, value, version)
+}
+
 func TestAccDatadogSyntheticsGlobalVariableTOTP_Basic(t *testing.T) {
 	t.Parallel()
 	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
@@ -0,0 +1,149 @@
+package test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/fwprovider"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+)
+
+func TestAccDatadogSyntheticsMobileApplication_Basic(t *testing.T) {
+	t.Parallel()
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	uniq := uniqueEntityName(ctx, t)
+	filePath := filepath.Join(t.TempDir(), "app.apk")
+	writeFile := func(content string) func() {
+		return func() {
+			if err := os.WriteFile(filePath, []byte(content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	writeFile("first build")()
+
+	var firstVersionID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testAccCheckDatadogSyntheticsMobileApplicationDestroy(providers.frameworkProvider),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogSyntheticsMobileApplicationConfig(uniq, "First build", filePath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("datadog_synthetics_mobile_application.app", "name", uniq),
+					resource.TestCheckResourceAttr("datadog_synthetics_mobile_application.app", "platform", "android"),
+					resource.TestCheckResourceAttr("datadog_synthetics_mobile_application.app", "description", "First build"),
+					resource.TestCheckResourceAttr("datadog_synthetics_mobile_application.app", "tags.#", "2"),
+					resource.TestCheckResourceAttrPair("datadog_synthetics_mobile_application_version.build", "application_id", "datadog_synthetics_mobile_application.app", "id"),
+					resource.TestCheckResourceAttr("datadog_synthetics_mobile_application_version.build", "version_name", "1.0.0"),
+					resource.TestCheckResourceAttr("datadog_synthetics_mobile_application_version.build", "is_latest", "true"),
+					resource.TestCheckResourceAttr("datadog_synthetics_mobile_application_version.build", "file_hash", testAccSyntheticsMobileApplicationHash("first build")),
+					resource.TestMatchResourceAttr("datadog_synthetics_mobile_application_version.build", "file_name", regexp.MustCompile(`\.apk$`)),
+					func(s *terraform.State) error {
+						firstVersionID = s.RootModule().Resources["datadog_synthetics_mobile_application_version.build"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// Changing the content of the file uploads a new version
+				PreConfig: writeFile("second build"),
+				Config:    testAccCheckDatadogSyntheticsMobileApplicationConfig(uniq, "Second build", filePath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("datadog_synthetics_mobile_application.app", "description", "Second build"),
+					resource.TestCheckResourceAttr("datadog_synthetics_mobile_application_version.build", "file_hash", testAccSyntheticsMobileApplicationHash("second build")),
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources["datadog_synthetics_mobile_application_version.build"].Primary.ID; id == firstVersionID {
+							return fmt.Errorf("expected a new version to be uploaded, got %s", id)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// The uploaded version is kept when its file is gone
+				PreConfig: func() {
+					if err := os.Remove(filePath); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:   testAccCheckDatadogSyntheticsMobileApplicationConfig(uniq, "Second build", filePath),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      "datadog_synthetics_mobile_application.app",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName: "datadog_synthetics_mobile_application_version.build",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					version := s.RootModule().Resources["datadog_synthetics_mobile_application_version.build"].Primary
+					return version.Attributes["application_id"] + ":" + version.ID, nil
+				},
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"file_path", "file_hash"},
+			},
+		},
+	})
+}
+
+func testAccCheckDatadogSyntheticsMobileApplicationConfig(uniq string, description string, filePath string) string {
+	return fmt.Sprintf(`
+resource "datadog_synthetics_mobile_application" "app" {
+  name        = "%s"
+  platform    = "android"
+  description = "%s"
+  tags        = ["team:mobile", "env:staging"]
+}
+
+resource "datadog_synthetics_mobile_application_version" "build" {
+  application_id = datadog_synthetics_mobile_application.app.id
+  version_name   = "1.0.0"
+  file_path      = "%s"
+  is_latest      = true
+}`, uniq, description, filePath)
+}
+
+func testAccSyntheticsMobileApplicationHash(content string) string {
+	hash := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(hash[:])
+}
+
+func testAccCheckDatadogSyntheticsMobileApplicationDestroy(accProvider *fwprovider.FrameworkProvider) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		apiInstances := accProvider.DatadogApiInstances
+		auth := accProvider.Auth
+
+		for _, r := range s.RootModule().Resources {
+			if r.Type != "datadog_synthetics_mobile_application" {
+				continue
+			}
+			err := utils.Retry(2, 10, func() error {
+				_, httpResp, err := utils.SendRequest(auth, apiInstances.HttpClient, "GET", "/api/unstable/synthetics/mobile/applications/"+r.Primary.ID, nil)
+				if err != nil {
+					if httpResp != nil && httpResp.StatusCode == 404 {
+						return nil
+					}
+					return &utils.RetryableError{Prob: fmt.Sprintf("received an error retrieving mobile application %s", err)}
+				}
+				return &utils.RetryableError{Prob: "Mobile application still exists"}
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
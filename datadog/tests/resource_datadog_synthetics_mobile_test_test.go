@@ -0,0 +1,106 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatadogSyntheticsMobileTestResource_Basic(t *testing.T) {
+	t.Parallel()
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	uniq := uniqueEntityName(ctx, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testAccCheckDatadogSyntheticsTestKindDestroy(providers.frameworkProvider, "datadog_synthetics_mobile_test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogSyntheticsMobileTestResourceConfig(uniq, 43200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogSyntheticsTestKindExists(providers.frameworkProvider, "datadog_synthetics_mobile_test.foo"),
+					resource.TestCheckResourceAttr("datadog_synthetics_mobile_test.foo", "name", uniq),
+					resource.TestCheckResourceAttr("datadog_synthetics_mobile_test.foo", "config_initial_application_arguments.test_process_argument", "test1"),
+					resource.TestCheckResourceAttr("datadog_synthetics_mobile_test.foo", "locations.#", "1"),
+					resource.TestCheckResourceAttr("datadog_synthetics_mobile_test.foo", "mobile_options_list.0.tick_every", "43200"),
+					resource.TestCheckResourceAttr("datadog_synthetics_mobile_test.foo", "mobile_options_list.0.device_ids.#", "1"),
+					resource.TestCheckResourceAttr("datadog_synthetics_mobile_test.foo", "mobile_options_list.0.mobile_application.0.reference_type", "version"),
+					resource.TestCheckResourceAttr("datadog_synthetics_mobile_test.foo", "mobile_step.#", "1"),
+					resource.TestCheckResourceAttr("datadog_synthetics_mobile_test.foo", "mobile_step.0.type", "tap"),
+					resource.TestCheckResourceAttr("datadog_synthetics_mobile_test.foo", "mobile_step.0.params.0.element.0.view_name", "StaticText"),
+					resource.TestCheckResourceAttrSet("datadog_synthetics_mobile_test.foo", "monitor_id"),
+				),
+			},
+			{
+				ResourceName:      "datadog_synthetics_mobile_test.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The locations aren't returned by the API for mobile tests
+				ImportStateVerifyIgnore: []string{"locations", "mobile_options_list", "mobile_step"},
+			},
+			{
+				Config: testAccCheckDatadogSyntheticsMobileTestResourceConfig(uniq, 86400),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogSyntheticsTestKindExists(providers.frameworkProvider, "datadog_synthetics_mobile_test.foo"),
+					resource.TestCheckResourceAttr("datadog_synthetics_mobile_test.foo", "mobile_options_list.0.tick_every", "86400"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatadogSyntheticsMobileTestResource_MovedFromSyntheticsTest(t *testing.T) {
+	testAccDatadogSyntheticsTestKindMoved(t, "datadog_synthetics_mobile_test", `type = "mobile"`, func(name string) string {
+		return testAccCheckDatadogSyntheticsMobileTestResourceConfig(name, 43200)
+	})
+}
+
+func testAccCheckDatadogSyntheticsMobileTestResourceConfig(name string, tickEvery int) string {
+	return fmt.Sprintf(`
+resource "datadog_synthetics_mobile_test" "foo" {
+	name      = "%s"
+	message   = "Notify @datadog.user"
+	tags      = ["foo:bar", "baz"]
+	status    = "paused"
+	locations = ["aws:eu-central-1"]
+
+	config_initial_application_arguments = {
+		test_process_argument = "test1"
+	}
+
+	mobile_options_list {
+		tick_every = %d
+		device_ids = ["synthetics:mobile:device:apple_iphone_14_plus_ios_16"]
+		mobile_application {
+			application_id = "ab0e0aed-536d-411a-9a99-5428c27d8f8e"
+			reference_id   = "6115922a-5f5d-455e-bc7e-7955a57f3815"
+			reference_type = "version"
+		}
+	}
+
+	mobile_step {
+		name = "Tap on StaticText \"Tap\""
+		type = "tap"
+		params {
+			element {
+				context      = "NATIVE_APP"
+				view_name    = "StaticText"
+				context_type = "native"
+				text_content = "Tap"
+				user_locator {
+					fail_test_on_cannot_locate = false
+					values {
+						type  = "id"
+						value = "some_id"
+					}
+				}
+			}
+		}
+		timeout     = 100
+		is_critical = true
+	}
+}`, name, tickEvery)
+}
@@ -0,0 +1,123 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatadogSyntheticsMultistepAPITestResource_Basic(t *testing.T) {
+	t.Parallel()
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	uniq := uniqueEntityName(ctx, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testAccCheckDatadogSyntheticsTestKindDestroy(providers.frameworkProvider, "datadog_synthetics_multistep_api_test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogSyntheticsMultistepAPITestResourceConfig(uniq, "Second api step"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogSyntheticsTestKindExists(providers.frameworkProvider, "datadog_synthetics_multistep_api_test.foo"),
+					resource.TestCheckResourceAttr("datadog_synthetics_multistep_api_test.foo", "name", uniq),
+					resource.TestCheckResourceAttr("datadog_synthetics_multistep_api_test.foo", "api_step.#", "2"),
+					resource.TestCheckResourceAttr("datadog_synthetics_multistep_api_test.foo", "api_step.0.name", "First api step"),
+					resource.TestCheckResourceAttr("datadog_synthetics_multistep_api_test.foo", "api_step.0.subtype", "http"),
+					resource.TestCheckResourceAttr("datadog_synthetics_multistep_api_test.foo", "api_step.0.request_headers.Accept", "application/json"),
+					resource.TestCheckResourceAttr("datadog_synthetics_multistep_api_test.foo", "api_step.0.extracted_value.0.name", "VAR_EXTRACT_BODY"),
+					resource.TestCheckResourceAttr("datadog_synthetics_multistep_api_test.foo", "api_step.0.retry.0.count", "5"),
+					resource.TestCheckResourceAttr("datadog_synthetics_multistep_api_test.foo", "api_step.1.name", "Second api step"),
+					resource.TestCheckResourceAttr("datadog_synthetics_multistep_api_test.foo", "api_step.1.request_definition.0.url", "https://docs.datadoghq.com"),
+					resource.TestCheckResourceAttr("datadog_synthetics_multistep_api_test.foo", "options_list.0.tick_every", "900"),
+					resource.TestCheckResourceAttrSet("datadog_synthetics_multistep_api_test.foo", "monitor_id"),
+				),
+			},
+			{
+				ResourceName:      "datadog_synthetics_multistep_api_test.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCheckDatadogSyntheticsMultistepAPITestResourceConfig(uniq, "Updated api step"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogSyntheticsTestKindExists(providers.frameworkProvider, "datadog_synthetics_multistep_api_test.foo"),
+					resource.TestCheckResourceAttr("datadog_synthetics_multistep_api_test.foo", "api_step.1.name", "Updated api step"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatadogSyntheticsMultistepAPITestResource_MovedFromSyntheticsTest(t *testing.T) {
+	testAccDatadogSyntheticsTestKindMoved(t, "datadog_synthetics_multistep_api_test", `type = "api"
+	subtype = "multi"`, func(name string) string {
+		return testAccCheckDatadogSyntheticsMultistepAPITestResourceConfig(name, "Second api step")
+	})
+}
+
+func testAccCheckDatadogSyntheticsMultistepAPITestResourceConfig(name, secondStepName string) string {
+	return fmt.Sprintf(`
+resource "datadog_synthetics_multistep_api_test" "foo" {
+	name      = "%s"
+	message   = "Notify @datadog.user"
+	tags      = ["multistep"]
+	status    = "paused"
+	locations = ["aws:eu-central-1"]
+
+	options_list {
+		tick_every          = 900
+		min_location_failed = 1
+	}
+
+	api_step {
+		name            = "First api step"
+		exit_if_succeed = true
+		request_definition {
+			method  = "POST"
+			url     = "https://www.datadoghq.com"
+			body    = "this is a body"
+			timeout = 30
+		}
+		request_headers = {
+			Accept = "application/json"
+		}
+		request_query = {
+			foo = "bar"
+		}
+		assertion {
+			type     = "statusCode"
+			operator = "is"
+			target   = "200"
+		}
+		extracted_value {
+			name = "VAR_EXTRACT_BODY"
+			type = "http_body"
+			parser {
+				type  = "json_path"
+				value = "$.id"
+			}
+		}
+		allow_failure = true
+		retry {
+			count    = 5
+			interval = 1000
+		}
+	}
+
+	api_step {
+		name = "%s"
+		request_definition {
+			method = "GET"
+			url    = "https://docs.datadoghq.com"
+		}
+		assertion {
+			type     = "statusCode"
+			operator = "is"
+			target   = "200"
+		}
+	}
+}`, name, secondStepName)
+}
@@ -35,7 +35,7 @@ func TestAccDatadogSyntheticsPrivateLocation_importBasic(t *testing.T) {
 				ResourceName:            "datadog_synthetics_private_location.foo",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"config", "api_key"},
+				ImportStateVerifyIgnore: []string{"config", "api_key", "kubernetes_secret_manifest", "helm_values", "docker_compose"},
 			},
 		},
 	})
@@ -103,6 +103,9 @@ func createSyntheticsPrivateLocationStep(ctx context.Context, accProvider *fwpro
 			resource.TestCheckResourceAttrSet(
 				"datadog_synthetics_private_location.foo", "id"),
 			checkRessourceAttributeRegex("datadog_synthetics_private_location.foo", "restriction_policy_resource_id", "synthetics-private-location:pl:.*"),
+			checkRessourceAttributeRegex("datadog_synthetics_private_location.foo", "kubernetes_secret_manifest", `(?s)kind: Secret\n.*name: synthetics-pl-.*stringData:\n  synthetics-check-runner.json: \|\n    \{\n.*"datadogApiKey": "1234567890"`),
+			checkRessourceAttributeRegex("datadog_synthetics_private_location.foo", "helm_values", `(?s)^configFile: \|\n  \{\n.*"accessKey": `),
+			checkRessourceAttributeRegex("datadog_synthetics_private_location.foo", "docker_compose", `(?s)image: datadog/synthetics-private-location-worker:\$\{SYNTHETICS_WORKER_VERSION:\?set the version of the private location worker\}\n.*target: /etc/datadog/synthetics-check-runner.json\n.*content: \|\n`),
 		),
 	}
 }
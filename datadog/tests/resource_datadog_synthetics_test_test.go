@@ -14,6 +14,7 @@ import (
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -278,6 +279,75 @@ func TestAccDatadogSyntheticsAPITest_AdvancedScheduling(t *testing.T) {
 	})
 }
 
+func TestAccDatadogSyntheticsAPITest_TriggerOnApply(t *testing.T) {
+	t.Parallel()
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	testName := uniqueEntityName(ctx, t)
+	accProvider := providers.sdkV2Provider
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testSyntheticsTestIsDestroyed(accProvider),
+		Steps: []resource.TestStep{
+			{
+				Config: createSyntheticsAPITestTriggerOnApplyConfig(testName, "200", "blocking"),
+				Check: resource.ComposeTestCheckFunc(
+					testSyntheticsTestExists(accProvider),
+					resource.TestCheckResourceAttr("datadog_synthetics_test.trigger", "trigger_on_apply.0.wait_for_result", "true"),
+					resource.TestCheckResourceAttr("datadog_synthetics_test.trigger", "trigger_on_apply.0.timeout", "1m"),
+					resource.TestCheckResourceAttr("datadog_synthetics_test.trigger", "trigger_on_apply.0.fail_on", "blocking"),
+				),
+			},
+			{
+				// Failing results with a non-blocking execution rule are only reported as warnings
+				Config: createSyntheticsAPITestTriggerOnApplyConfig(testName, "404", "non_blocking"),
+				Check: resource.ComposeTestCheckFunc(
+					testSyntheticsTestExists(accProvider),
+					resource.TestCheckResourceAttr("datadog_synthetics_test.trigger", "assertion.0.target", "404"),
+				),
+			},
+			{
+				Config:      createSyntheticsAPITestTriggerOnApplyConfig(testName, "404", "blocking"),
+				ExpectError: regexp.MustCompile(`(?s)failed on aws:eu-central-1.*INCORRECT_ASSERTION.*assertion failed: statusCode is 404, got 200`),
+			},
+		},
+	})
+}
+
+func createSyntheticsAPITestTriggerOnApplyConfig(uniq, target, executionRule string) string {
+	return fmt.Sprintf(`
+resource "datadog_synthetics_test" "trigger" {
+	type      = "api"
+	subtype   = "http"
+	name      = "%s"
+	status    = "paused"
+	locations = ["aws:eu-central-1"]
+
+	request_definition {
+		method = "GET"
+		url    = "https://www.datadoghq.com"
+	}
+
+	assertion {
+		type     = "statusCode"
+		operator = "is"
+		target   = "%s"
+	}
+
+	options_list {
+		tick_every = 60
+		ci {
+			execution_rule = "%s"
+		}
+	}
+
+	trigger_on_apply {
+		timeout = "1m"
+	}
+}`, uniq, target, executionRule)
+}
+
 func TestAccDatadogSyntheticsAPITest_UpdatedNewAssertionsOptions(t *testing.T) {
 	t.Parallel()
 	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
@@ -711,6 +781,162 @@ func TestAccDatadogSyntheticsBrowserTestBrowserNewBrowserStep_Basic(t *testing.T
 	})
 }
 
+func TestAccDatadogSyntheticsBrowserTest_Subtests(t *testing.T) {
+	t.Parallel()
+	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
+	accProvider := providers.sdkV2Provider
+	testName := uniqueEntityName(ctx, t)
+	// Referencing the parent test from the login test would be a cycle for Terraform, its ID is passed as a variable
+	var parentID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: accProviders,
+		CheckDestroy:             testSyntheticsTestIsDestroyed(accProvider),
+		Steps: []resource.TestStep{
+			{
+				Config: createSyntheticsBrowserTestSubtestsConfig(testName, "", ""),
+			},
+			{
+				Config:      createSyntheticsBrowserTestSubtestsConfig(testName, "", "datadog_synthetics_test.api.id"),
+				ExpectError: regexp.MustCompile(`browser_step.0: subtest [a-z0-9-]+ is a api test, only browser tests can be played as subtests`),
+			},
+			{
+				Config:      createSyntheticsBrowserTestSubtestsConfig(testName, "", "datadog_synthetics_test.login.id"),
+				ExpectError: regexp.MustCompile(`browser_step.0: subtest [a-z0-9-]+ uses variables that are not defined by it or by the tests playing it: USERNAME`),
+			},
+			{
+				Config: createSyntheticsBrowserTestSubtestsConfig(testName, "", "datadog_synthetics_test.login.id", "USERNAME"),
+				Check: resource.ComposeTestCheckFunc(
+					testSyntheticsTestExists(accProvider),
+					resource.TestCheckResourceAttrPair("datadog_synthetics_test.parent", "browser_step.0.params.0.subtest_public_id", "datadog_synthetics_test.login", "id"),
+					func(s *terraform.State) error {
+						parentID = s.RootModule().Resources["datadog_synthetics_test.parent"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// The login test can't play the test playing it
+				Config:          createSyntheticsBrowserTestSubtestsConfig(testName, "var.parent_id", "datadog_synthetics_test.login.id", "USERNAME"),
+				ConfigVariables: config.Variables{"parent_id": syntheticsLazyStringVariable(func() string { return parentID })},
+				ExpectError:     regexp.MustCompile(`browser_step.1: subtest [a-z0-9-]+ plays itself: [a-z0-9-]+ -> [a-z0-9-]+ -> [a-z0-9-]+`),
+			},
+		},
+	})
+}
+
+// createSyntheticsBrowserTestSubtestsConfig returns a login browser test using the USERNAME variable and an API test.
+// The login test plays loginSubtest when set, and a parent test plays parentSubtest with the given variables when set.
+func createSyntheticsBrowserTestSubtestsConfig(uniq string, loginSubtest string, parentSubtest string, parentVariables ...string) string {
+	loginSubtestStep, variable := "", ""
+	if loginSubtest == "var.parent_id" {
+		variable = `
+variable "parent_id" {
+	type = string
+}
+`
+	}
+	if loginSubtest != "" {
+		loginSubtestStep = fmt.Sprintf(`
+	browser_step {
+		name = "play"
+		type = "playSubTest"
+		params {
+			subtest_public_id = %s
+		}
+	}`, loginSubtest)
+	}
+	parent := ""
+	if parentSubtest != "" {
+		variables := ""
+		for _, name := range parentVariables {
+			variables += fmt.Sprintf(`
+	browser_variable {
+		type    = "text"
+		name    = "%s"
+		pattern = "frog"
+		example = "frog"
+	}`, name)
+		}
+		parent = fmt.Sprintf(`
+resource "datadog_synthetics_test" "parent" {
+	type      = "browser"
+	name      = "%[1]s-parent"
+	status    = "paused"
+	locations = ["aws:eu-central-1"]
+	device_ids = ["chrome.laptop_large"]
+	request_definition {
+		method = "GET"
+		url    = "https://www.datadoghq.com"
+	}
+	options_list {
+		tick_every = 900
+	}
+%[3]s
+	browser_step {
+		name = "login"
+		type = "playSubTest"
+		params {
+			subtest_public_id = %[2]s
+		}
+	}
+}`, uniq, parentSubtest, variables)
+	}
+
+	return fmt.Sprintf(`%[4]s
+resource "datadog_synthetics_test" "api" {
+	type      = "api"
+	subtype   = "http"
+	name      = "%[1]s-api"
+	status    = "paused"
+	locations = ["aws:eu-central-1"]
+	request_definition {
+		method = "GET"
+		url    = "https://www.datadoghq.com"
+	}
+	assertion {
+		type     = "statusCode"
+		operator = "is"
+		target   = "200"
+	}
+	options_list {
+		tick_every = 900
+	}
+}
+
+resource "datadog_synthetics_test" "login" {
+	type      = "browser"
+	name      = "%[1]s-login"
+	status    = "paused"
+	locations = ["aws:eu-central-1"]
+	device_ids = ["chrome.laptop_large"]
+	request_definition {
+		method = "GET"
+		url    = "https://www.datadoghq.com/login"
+	}
+	options_list {
+		tick_every = 900
+	}
+	browser_step {
+		name = "type username"
+		type = "typeText"
+		params {
+			value   = "{{ USERNAME }}"
+			element = "{\"targetOuterHTML\":\"<input id=\\\"username\\\">\"}"
+		}
+	}%[2]s
+}
+%[3]s`, uniq, loginSubtestStep, parent, variable)
+}
+
+// syntheticsLazyStringVariable is a string variable whose value is only known when the test step runs.
+type syntheticsLazyStringVariable func() string
+
+func (v syntheticsLazyStringVariable) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v())
+}
+
 func TestAccDatadogSyntheticsTestBrowserMML_Basic(t *testing.T) {
 	t.Parallel()
 	ctx, providers, accProviders := testAccFrameworkMuxProviders(context.Background(), t)
@@ -0,0 +1,17 @@
+# Find dashboards owned by a team that don't belong to any dashboard list
+data "datadog_dashboards" "orphans" {
+  filter_tags              = ["team:*"]
+  filter_in_dashboard_list = false
+}
+
+resource "datadog_dashboard_list" "triage" {
+  name = "Dashboards to triage"
+
+  dynamic "dash_item" {
+    for_each = data.datadog_dashboards.orphans.dashboards
+    content {
+      type    = dash_item.value.layout_type == "free" ? "custom_screenboard" : "custom_timeboard"
+      dash_id = dash_item.value.id
+    }
+  }
+}
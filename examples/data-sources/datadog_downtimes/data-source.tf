@@ -0,0 +1,10 @@
+# Refuse to deploy while a downtime silences the production monitors of a service
+data "datadog_downtimes" "active" {
+  current_only        = true
+  filter_scope        = "env:prod"
+  filter_monitor_tags = ["service:checkout"]
+}
+
+output "deploy_blocked" {
+  value = length(data.datadog_downtimes.active.downtimes) > 0
+}
@@ -0,0 +1,32 @@
+# Snapshot of a single metric query over the last hour
+data "datadog_graph_snapshot" "load" {
+  metric_query = "avg:system.load.1{env:prod} by {host}"
+  title        = "System load"
+}
+
+# Snapshot of a timeseries graph over the last 4 hours, using the same request
+# structure as the `timeseries_definition` widget of `datadog_dashboard`
+data "datadog_graph_snapshot" "cpu" {
+  title     = "CPU usage"
+  timeframe = "4h"
+  width     = 800
+  height    = 400
+
+  request {
+    query {
+      metric_query {
+        data_source = "metrics"
+        query       = "avg:system.cpu.user{env:prod} by {service}"
+        name        = "cpu"
+      }
+    }
+    formula {
+      formula_expression = "cpu"
+    }
+    display_type = "line"
+  }
+}
+
+output "cpu_graph_url" {
+  value = data.datadog_graph_snapshot.cpu.snapshot_url
+}
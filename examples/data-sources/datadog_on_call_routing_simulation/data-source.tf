@@ -0,0 +1,12 @@
+data "datadog_on_call_routing_simulation" "prod_outage" {
+  team_id   = datadog_on_call_team_routing_rules.team_rules.id
+  tags      = ["env:prod", "service:web-store"]
+  priority  = "P1"
+  timestamp = "2026-01-03T02:00:00Z"
+}
+
+# With `terraform test`, assert that the alert pages the primary escalation policy
+# assert {
+#   condition     = data.datadog_on_call_routing_simulation.prod_outage.escalation_policy == datadog_on_call_escalation_policy.primary.id
+#   error_message = "Production outages must page the primary escalation policy."
+# }
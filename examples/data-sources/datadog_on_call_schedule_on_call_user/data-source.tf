@@ -0,0 +1,8 @@
+data "datadog_on_call_schedule_on_call_user" "now" {
+  schedule_id = datadog_on_call_schedule.primary.id
+}
+
+data "datadog_on_call_schedule_on_call_user" "new_year" {
+  schedule_id = datadog_on_call_schedule.primary.id
+  at          = "2026-01-01T00:00:00Z"
+}
@@ -0,0 +1,15 @@
+# Block deployments of a service when the error budget of its SLO is exhausted
+data "datadog_service_level_objective_status" "checkout" {
+  slo_id = "12345678901234567890123456789012"
+}
+
+resource "terraform_data" "deployment" {
+  input = var.checkout_version
+
+  lifecycle {
+    precondition {
+      condition     = !data.datadog_service_level_objective_status.checkout.budget_exhausted
+      error_message = "The error budget of the checkout SLO is exhausted, deployments are frozen."
+    }
+  }
+}
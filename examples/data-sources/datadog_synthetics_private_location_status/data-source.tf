@@ -0,0 +1,11 @@
+data "datadog_synthetics_private_location_status" "private_location" {
+  private_location_id = datadog_synthetics_private_location.private_location.id
+  lookback_minutes    = 30
+}
+
+check "private_location_health" {
+  assert {
+    condition     = data.datadog_synthetics_private_location_status.private_location.status == "up"
+    error_message = "No worker of the private location sent a heartbeat in the last 30 minutes."
+  }
+}
@@ -0,0 +1,9 @@
+# List the live synthetics tests of a team that block CI/CD pipelines
+data "datadog_synthetics_tests" "blocking" {
+  filter_tags   = ["team:checkout", "env:prod"]
+  filter_status = "live"
+}
+
+output "blocking_test_ids" {
+  value = [for test in data.datadog_synthetics_tests.blocking.tests : test.public_id if test.execution_rule == "blocking"]
+}
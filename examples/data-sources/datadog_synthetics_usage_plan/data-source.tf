@@ -0,0 +1,20 @@
+# Estimate the load of the tests running from a private location, including a test to be added
+data "datadog_synthetics_tests" "private" {
+  filter_location = datadog_synthetics_private_location.office.id
+}
+
+data "datadog_synthetics_usage_plan" "private" {
+  test_ids = [for test in data.datadog_synthetics_tests.private.tests : test.public_id]
+
+  planned_test {
+    name        = "Checkout"
+    tick_every  = 300
+    locations   = [datadog_synthetics_private_location.office.id]
+    retry_count = 1
+    device_ids  = ["chrome.laptop_large"]
+  }
+}
+
+output "private_location_peak_concurrency" {
+  value = data.datadog_synthetics_usage_plan.private.concurrent_executions_upper_bound
+}
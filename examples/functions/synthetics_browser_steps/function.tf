@@ -0,0 +1,53 @@
+# Create a browser test from a recording exported from Datadog
+locals {
+  login_steps = provider::datadog::synthetics_browser_steps(file("${path.module}/login-recording.json"))
+}
+
+resource "datadog_synthetics_test" "login" {
+  type       = "browser"
+  name       = "Login"
+  status     = "live"
+  locations  = ["aws:eu-central-1"]
+  device_ids = ["chrome.laptop_large"]
+  request_definition {
+    method = "GET"
+    url    = "https://app.example.com/login"
+  }
+  options_list {
+    tick_every = 3600
+  }
+
+  dynamic "browser_step" {
+    for_each = local.login_steps
+    content {
+      name          = browser_step.value.name
+      type          = browser_step.value.type
+      allow_failure = browser_step.value.allow_failure
+      is_critical   = browser_step.value.is_critical
+      timeout       = browser_step.value.timeout
+      params {
+        check   = browser_step.value.params.check
+        code    = browser_step.value.params.code
+        element = browser_step.value.params.element
+        value   = browser_step.value.params.value
+        dynamic "element_user_locator" {
+          for_each = browser_step.value.params.element_user_locator[*]
+          content {
+            fail_test_on_cannot_locate = element_user_locator.value.fail_test_on_cannot_locate
+            value {
+              type  = element_user_locator.value.value.type
+              value = element_user_locator.value.value.value
+            }
+          }
+        }
+        dynamic "variable" {
+          for_each = browser_step.value.params.variable[*]
+          content {
+            name    = variable.value.name
+            example = variable.value.example
+          }
+        }
+      }
+    }
+  }
+}
@@ -0,0 +1,2 @@
+# Dashboard list items can be imported using the dashboard list ID and the dashboard ID, separated by a colon
+terraform import datadog_dashboard_list_item.team "123456:abc-def-ghi"
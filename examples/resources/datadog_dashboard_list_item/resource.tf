@@ -0,0 +1,16 @@
+# A Dashboard List shared by several teams, which only manages its own dashboards
+resource "datadog_dashboard_list" "shared" {
+  name                  = "Shared Team Dashboards"
+  ignore_external_items = true
+  dash_item {
+    type    = "custom_timeboard"
+    dash_id = datadog_dashboard.overview.id
+  }
+}
+
+# Add a team dashboard to the shared list from another module
+resource "datadog_dashboard_list_item" "team" {
+  dashboard_list_id = datadog_dashboard_list.shared.id
+  dash_id           = datadog_dashboard.team.id
+  type              = "custom_timeboard"
+}
@@ -0,0 +1,2 @@
+# The shared dashboard token can be found in the URL of the shared dashboard.
+terraform import datadog_dashboard_share.status "abcdef0123456789abcdef0123456789"
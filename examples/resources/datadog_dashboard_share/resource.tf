@@ -0,0 +1,43 @@
+resource "datadog_dashboard" "status" {
+  title       = "Public status"
+  layout_type = "ordered"
+
+  template_variable {
+    name   = "env"
+    prefix = "env"
+  }
+
+  widget {
+    note_definition {
+      content = "All systems operational"
+    }
+  }
+}
+
+# Share a dashboard publicly
+resource "datadog_dashboard_share" "status" {
+  dashboard_id          = datadog_dashboard.status.id
+  dashboard_type        = "custom_timeboard"
+  share_type            = "open"
+  title                 = "Status page"
+  global_time_live_span = "1h"
+
+  selectable_template_var {
+    name          = "env"
+    prefix        = "env"
+    default_value = "prod"
+    visible_tags  = ["prod", "staging"]
+  }
+}
+
+# Share a dashboard with a list of invited viewers
+resource "datadog_dashboard_share" "partners" {
+  dashboard_id   = datadog_dashboard.status.id
+  dashboard_type = "custom_timeboard"
+  share_type     = "invite"
+  invitees       = ["partner@example.com"]
+}
+
+output "status_page_url" {
+  value = datadog_dashboard_share.status.public_url
+}
@@ -2,6 +2,11 @@ resource "datadog_on_call_schedule" "test" {
   name      = "Team A On-Call"
   time_zone = "America/New_York"
   teams     = ["00000000-aba2-0000-0000-000000000000"]
+
+  # Fail the plan when the layers leave nobody on call in the next 4 weeks
+  coverage_horizon_days = 28
+  require_full_coverage = true
+
   layer {
     name           = "Primary On-Call Layer"
     effective_date = "2025-01-01T00:00:00Z"
@@ -2,9 +2,8 @@
 # Create a new Datadog service level objective
 resource "datadog_service_level_objective" "foo" {
   name        = "Example Metric SLO"
-  type        = "metric"
   description = "My custom metric SLO"
-  query {
+  metric {
     numerator   = "sum:my.custom.count.metric{type:good_events}.as_count()"
     denominator = "sum:my.custom.count.metric{*}.as_count()"
   }
@@ -33,9 +32,10 @@ resource "datadog_service_level_objective" "foo" {
 # Create a new Datadog service level objective
 resource "datadog_service_level_objective" "bar" {
   name        = "Example Monitor SLO"
-  type        = "monitor"
   description = "My custom monitor SLO"
-  monitor_ids = [1, 2, 3]
+  monitor {
+    monitor_ids = [1, 2, 3]
+  }
 
   thresholds {
     timeframe = "7d"
@@ -58,24 +58,21 @@ resource "datadog_service_level_objective" "bar" {
 
 resource "datadog_service_level_objective" "time_slice_slo" {
   name        = "Example Time Slice SLO"
-  type        = "time_slice"
   description = "My custom time slice SLO"
-  sli_specification {
-    time_slice {
+  time_slice {
+    query {
+      formula {
+        formula_expression = "query1"
+      }
       query {
-        formula {
-          formula_expression = "query1"
-        }
-        query {
-          metric_query {
-            name  = "query1"
-            query = "avg:my.custom.count.metric{*}.as_count()"
-          }
+        metric_query {
+          name  = "query1"
+          query = "avg:my.custom.count.metric{*}.as_count()"
         }
       }
-      comparator = ">"
-      threshold  = 0.9
     }
+    comparator = ">"
+    threshold  = 0.9
   }
 
   thresholds {
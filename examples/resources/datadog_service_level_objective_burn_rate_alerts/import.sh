@@ -0,0 +1,2 @@
+# Burn rate alerts can be imported using the ID of their service level objective. The `policy` is set when the monitors match a predefined policy, the `alert` blocks otherwise.
+terraform import datadog_service_level_objective_burn_rate_alerts.checkout 12345678901234567890123456789012
@@ -0,0 +1,33 @@
+resource "datadog_service_level_objective" "checkout" {
+  name = "Checkout availability"
+  metric {
+    numerator   = "sum:checkout.requests{status:ok}.as_count()"
+    denominator = "sum:checkout.requests{*}.as_count()"
+  }
+  thresholds {
+    timeframe = "30d"
+    target    = 99.9
+  }
+}
+
+# Page on the fast and slow burn rates of the Google SRE workbook
+resource "datadog_service_level_objective_burn_rate_alerts" "checkout" {
+  slo_id   = datadog_service_level_objective.checkout.id
+  policy   = "google_sre"
+  message  = "Checkout is burning its error budget @pagerduty-checkout"
+  priority = 1
+  tags     = ["team:checkout"]
+}
+
+# Custom alerts
+resource "datadog_service_level_objective_burn_rate_alerts" "checkout_ticket" {
+  slo_id  = datadog_service_level_objective.checkout.id
+  message = "Checkout is slowly burning its error budget @jira-checkout"
+
+  alert {
+    name         = "ticket"
+    long_window  = "1d"
+    short_window = "2h"
+    threshold    = 3
+  }
+}
@@ -2,9 +2,8 @@
 
 resource "datadog_service_level_objective" "example_slo" {
   name        = "example slo"
-  type        = "metric"
   description = "some updated description about example_slo SLO"
-  query {
+  metric {
     numerator   = "sum:my.metric{type:good}.as_count()"
     denominator = "sum:my.metric{type:good}.as_count() + sum:my.metric{type:bad}.as_count()"
   }
@@ -0,0 +1,2 @@
+# Synthetics tests can be imported using their public string ID, e.g.
+terraform import datadog_synthetics_api_test.foo abc-123-xyz
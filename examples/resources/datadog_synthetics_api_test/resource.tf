@@ -0,0 +1,42 @@
+# Example Usage (Synthetics API test)
+# Create a new Datadog Synthetics API/HTTP test on https://www.example.org
+resource "datadog_synthetics_api_test" "test_uptime" {
+  name      = "An Uptime test on example.org"
+  subtype   = "http"
+  status    = "live"
+  message   = "Notify @pagerduty"
+  locations = ["aws:eu-central-1"]
+  tags      = ["foo:bar", "foo", "env:test"]
+
+  request_definition {
+    method = "GET"
+    url    = "https://www.example.org"
+  }
+
+  request_headers = {
+    Content-Type = "application/json"
+  }
+
+  assertion {
+    type     = "statusCode"
+    operator = "is"
+    target   = "200"
+  }
+
+  options_list {
+    tick_every = 900
+    retry {
+      count    = 2
+      interval = 300
+    }
+    monitor_options {
+      renotify_interval = 120
+    }
+  }
+}
+
+# Move an API test managed with the `datadog_synthetics_test` resource (requires Terraform 1.8 or later)
+moved {
+  from = datadog_synthetics_test.test_api
+  to   = datadog_synthetics_api_test.test_api
+}
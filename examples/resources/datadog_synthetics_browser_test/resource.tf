@@ -0,0 +1,41 @@
+# Example Usage (Synthetics Browser test)
+# Create a new Datadog Synthetics Browser test starting on https://www.example.org
+resource "datadog_synthetics_browser_test" "test_browser" {
+  name       = "A Browser test on example.org"
+  status     = "paused"
+  message    = "Notify @qa"
+  device_ids = ["laptop_large"]
+  locations  = ["aws:eu-central-1"]
+  tags       = []
+
+  request_definition {
+    method = "GET"
+    url    = "https://app.datadoghq.com"
+  }
+
+  browser_step {
+    name = "Check current url"
+    type = "assertCurrentUrl"
+    params {
+      check = "contains"
+      value = "datadoghq"
+    }
+  }
+
+  browser_variable {
+    type    = "text"
+    name    = "MY_PATTERN_VAR"
+    pattern = "{{numeric(3)}}"
+    example = "597"
+  }
+
+  options_list {
+    tick_every = 3600
+  }
+}
+
+# Move a browser test managed with the `datadog_synthetics_test` resource (requires Terraform 1.8 or later)
+moved {
+  from = datadog_synthetics_test.test_browser
+  to   = datadog_synthetics_browser_test.test_browser
+}
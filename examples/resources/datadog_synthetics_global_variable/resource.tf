@@ -6,3 +6,17 @@ resource "datadog_synthetics_global_variable" "test_variable" {
   tags        = ["foo:bar", "env:test"]
   value       = "variable-value"
 }
+
+# Create a secure synthetics_global_variable from an ephemeral value, which is never stored in the state
+ephemeral "vault_kv_secret_v2" "api_token" {
+  mount = "secret"
+  name  = "synthetics/api-token"
+}
+
+resource "datadog_synthetics_global_variable" "api_token" {
+  name             = "API_TOKEN"
+  description      = "Token used by the synthetics tests"
+  secure           = true
+  value_wo         = ephemeral.vault_kv_secret_v2.api_token.data.token
+  value_wo_version = 1 # Increment to rotate the value
+}
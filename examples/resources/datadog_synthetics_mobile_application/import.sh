@@ -0,0 +1,2 @@
+# Synthetics mobile applications can be imported using their string ID, e.g.
+terraform import datadog_synthetics_mobile_application.shop ab0e0aed-536d-411a-9a99-5428c27d8f8e
@@ -0,0 +1,7 @@
+# Create a new Datadog Synthetics mobile application
+resource "datadog_synthetics_mobile_application" "shop" {
+  name        = "Shop"
+  platform    = "android"
+  description = "The Android application of the shop"
+  tags        = ["team:mobile"]
+}
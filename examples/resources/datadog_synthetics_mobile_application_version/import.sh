@@ -0,0 +1,2 @@
+# Synthetics mobile application versions can be imported using the ID of their application and their ID separated by a colon, e.g.
+terraform import datadog_synthetics_mobile_application_version.shop_1_2_0 "ab0e0aed-536d-411a-9a99-5428c27d8f8e:6c3e6f7d-0b8e-4c7f-8a52-9b0d6a1f2e3c"
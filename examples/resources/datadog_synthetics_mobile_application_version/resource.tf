@@ -0,0 +1,22 @@
+# Upload a build of a mobile application and pin a mobile test to it
+resource "datadog_synthetics_mobile_application_version" "shop_1_2_0" {
+  application_id = datadog_synthetics_mobile_application.shop.id
+  version_name   = "1.2.0"
+  file_path      = "${path.module}/build/shop-1.2.0.apk"
+  is_latest      = true
+}
+
+resource "datadog_synthetics_mobile_test" "checkout" {
+  name   = "Checkout"
+  status = "live"
+
+  mobile_options_list {
+    tick_every = 43200
+    device_ids = ["synthetics:mobile:device:pixel_7_android_14"]
+    mobile_application {
+      application_id = datadog_synthetics_mobile_application_version.shop_1_2_0.application_id
+      reference_id   = datadog_synthetics_mobile_application_version.shop_1_2_0.id
+      reference_type = "version"
+    }
+  }
+}
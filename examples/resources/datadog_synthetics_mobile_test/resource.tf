@@ -0,0 +1,51 @@
+# Example Usage (Synthetics Mobile test)
+# Create a new Datadog Synthetics Mobile test starting on https://www.example.org
+resource "datadog_synthetics_mobile_test" "test_mobile" {
+  name      = "A Mobile test on example.org"
+  status    = "paused"
+  message   = "Notify @datadog.user"
+  tags      = ["foo:bar", "baz"]
+  locations = ["aws:eu-central-1"]
+
+  config_initial_application_arguments = {
+    test_process_argument = "test1"
+  }
+
+  mobile_options_list {
+    tick_every = 43200
+    device_ids = ["synthetics:mobile:device:apple_iphone_14_plus_ios_16"]
+    mobile_application {
+      application_id = "ab0e0aed-536d-411a-9a99-5428c27d8f8e"
+      reference_id   = "6115922a-5f5d-455e-bc7e-7955a57f3815"
+      reference_type = "version"
+    }
+  }
+
+  mobile_step {
+    name = "Tap on StaticText \"Tap\""
+    type = "tap"
+    params {
+      element {
+        context      = "NATIVE_APP"
+        view_name    = "StaticText"
+        context_type = "native"
+        text_content = "Tap"
+        user_locator {
+          fail_test_on_cannot_locate = false
+          values {
+            type  = "id"
+            value = "some_id"
+          }
+        }
+      }
+    }
+    timeout     = 100
+    is_critical = true
+  }
+}
+
+# Move a mobile test managed with the `datadog_synthetics_test` resource (requires Terraform 1.8 or later)
+moved {
+  from = datadog_synthetics_test.test_mobile
+  to   = datadog_synthetics_mobile_test.test_mobile
+}
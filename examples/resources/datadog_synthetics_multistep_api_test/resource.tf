@@ -0,0 +1,54 @@
+# Example Usage (Synthetics Multistep API test)
+# Create a new Datadog Synthetics Multistep API test
+resource "datadog_synthetics_multistep_api_test" "test_multi_step" {
+  name      = "Multistep API test"
+  status    = "live"
+  locations = ["aws:eu-central-1"]
+
+  api_step {
+    name    = "An API test on example.org"
+    subtype = "http"
+
+    assertion {
+      type     = "statusCode"
+      operator = "is"
+      target   = "200"
+    }
+
+    request_definition {
+      method = "GET"
+      url    = "https://www.example.org"
+    }
+
+    request_headers = {
+      Content-Type   = "application/json"
+      Authentication = "Token: 1234566789"
+    }
+  }
+
+  api_step {
+    name    = "An API test on example.org"
+    subtype = "http"
+
+    assertion {
+      type     = "statusCode"
+      operator = "is"
+      target   = "200"
+    }
+
+    request_definition {
+      method = "GET"
+      url    = "http://example.org"
+    }
+  }
+
+  options_list {
+    tick_every = 900
+  }
+}
+
+# Move a multistep API test managed with the `datadog_synthetics_test` resource (requires Terraform 1.8 or later)
+moved {
+  from = datadog_synthetics_test.test_multi
+  to   = datadog_synthetics_multistep_api_test.test_multi
+}
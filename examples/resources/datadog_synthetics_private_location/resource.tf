@@ -3,3 +3,11 @@ resource "datadog_synthetics_private_location" "private_location" {
   description = "Description of the private location"
   tags        = ["foo:bar", "env:test"]
 }
+
+# Deploy the private location worker to Kubernetes with its official Helm chart
+resource "helm_release" "private_location" {
+  name       = "synthetics-private-location"
+  repository = "https://helm.datadoghq.com"
+  chart      = "synthetics-private-location"
+  values     = [datadog_synthetics_private_location.private_location.helm_values]
+}
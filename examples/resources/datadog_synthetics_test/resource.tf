@@ -34,6 +34,13 @@ resource "datadog_synthetics_test" "test_uptime" {
       renotify_interval = 120
     }
   }
+
+  # Run the test after each change, and fail the apply if it fails
+  trigger_on_apply {
+    wait_for_result = true
+    timeout         = "5m"
+    fail_on         = "blocking"
+  }
 }
 
 